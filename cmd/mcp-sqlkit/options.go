@@ -7,6 +7,8 @@ type Options struct {
 	ConfigPath            string `short:"c" long:"config" description:"Path to JSON configuration file"`
 	DefaultConnectorsPath string `short:"d" long:"default-connectors" description:"Path to JSON file containing default connectors only (either an array of namespaced connector entries or an object with connector.defaultConnectors)"`
 	MemoryReportSeconds   int    `long:"mem-report-seconds" description:"Log Go memory stats every N seconds (0 disables)"`
+	MetadataCacheSeconds  int    `long:"metadata-cache-seconds" description:"Cache catalog metadata for N seconds (0 uses config or default 300, negative disables)"`
+	AnnotationsURL        string `long:"annotations" description:"Base URL of table/column annotation files (mem://, file://, gs://, ...; defaults to mcp-sqlkit/annotations under the user config directory)"`
 
 	// Return tool results using the `data` field instead of the default
 	// `text` field (negates the config's default behaviour).
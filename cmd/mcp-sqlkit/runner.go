@@ -138,6 +138,12 @@ func loadConfig(opts *Options) (*mcp.Config, error) {
 	if opts.UseData {
 		cfg.UseData = true // CLI override
 	}
+	if opts.MetadataCacheSeconds != 0 {
+		cfg.MetadataCacheTTLSeconds = opts.MetadataCacheSeconds // CLI override
+	}
+	if opts.AnnotationsURL != "" {
+		cfg.AnnotationsURL = opts.AnnotationsURL // CLI override
+	}
 
 	if aPolicy := cfg.Connector.Policy; aPolicy != nil {
 		if aPolicy.Oauth2Config == nil && opts.Oauth2Config != "" {
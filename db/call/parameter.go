@@ -0,0 +1,149 @@
+package call
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// ModeIn marks input parameter.
+	ModeIn = "in"
+	// ModeOut marks output parameter.
+	ModeOut = "out"
+	// ModeInOut marks input/output parameter.
+	ModeInOut = "inout"
+)
+
+// Parameter represents a procedure/function argument.
+type Parameter struct {
+	// Name of the parameter used as key of the output values (defaults to p<position>).
+	Name string `json:"name,omitempty"`
+
+	// Mode of the parameter (default in).
+	Mode string `json:"mode,omitempty" choice:"in" choice:"out" choice:"inout"`
+
+	// Value of in/inout parameter.
+	Value interface{} `json:"value,omitempty"`
+
+	// Type of out/inout value (default string).
+	Type string `json:"type,omitempty" choice:"string" choice:"int" choice:"float" choice:"bool" choice:"time"`
+}
+
+func (p *Parameter) mode() string {
+	switch strings.ToLower(p.Mode) {
+	case ModeOut:
+		return ModeOut
+	case ModeInOut, "in_out", "in out":
+		return ModeInOut
+	}
+	return ModeIn
+}
+
+func (p *Parameter) isOutput() bool {
+	return p.mode() != ModeIn
+}
+
+func (p *Parameter) key(position int) string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return fmt.Sprintf("p%d", position)
+}
+
+// destination returns pointer receiving an out value, initialised with the in value for inout parameters.
+func (p *Parameter) destination() interface{} {
+	switch strings.ToLower(p.Type) {
+	case "int", "integer", "int64":
+		ret := new(sql.NullInt64)
+		if p.mode() == ModeInOut {
+			_ = ret.Scan(p.Value)
+		}
+		return ret
+	case "float", "double", "number", "float64":
+		ret := new(sql.NullFloat64)
+		if p.mode() == ModeInOut {
+			_ = ret.Scan(p.Value)
+		}
+		return ret
+	case "bool", "boolean":
+		ret := new(sql.NullBool)
+		if p.mode() == ModeInOut {
+			_ = ret.Scan(p.Value)
+		}
+		return ret
+	case "time", "timestamp", "date":
+		ret := new(sql.NullTime)
+		if p.mode() == ModeInOut {
+			_ = ret.Scan(p.Value)
+		}
+		return ret
+	}
+	ret := new(sql.NullString)
+	if p.mode() == ModeInOut && p.Value != nil {
+		_ = ret.Scan(fmt.Sprintf("%v", p.Value))
+	}
+	return ret
+}
+
+// timeLayouts lists textual time formats returned for time out values.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02"}
+
+// convert casts a raw driver value, e.g. MySQL session variable bytes, to the declared parameter type;
+// values that do not convert are returned as they are.
+func (p *Parameter) convert(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	dest := (&Parameter{Type: p.Type, Mode: ModeOut}).destination()
+	if data, ok := value.([]byte); ok {
+		value = string(data)
+	}
+	if text, ok := value.(string); ok {
+		if _, isTime := dest.(*sql.NullTime); isTime {
+			for _, layout := range timeLayouts {
+				if ts, err := time.Parse(layout, text); err == nil {
+					return ts.Format(time.RFC3339Nano)
+				}
+			}
+			return text
+		}
+	}
+	if err := dest.(sql.Scanner).Scan(value); err != nil {
+		return value
+	}
+	return dereference(dest)
+}
+
+// dereference returns plain value held by a destination pointer.
+func dereference(dest interface{}) interface{} {
+	switch actual := dest.(type) {
+	case *sql.NullInt64:
+		if actual.Valid {
+			return actual.Int64
+		}
+	case *sql.NullFloat64:
+		if actual.Valid {
+			return actual.Float64
+		}
+	case *sql.NullBool:
+		if actual.Valid {
+			return actual.Bool
+		}
+	case *sql.NullTime:
+		if actual.Valid {
+			return actual.Time.Format(time.RFC3339Nano)
+		}
+	case *sql.NullString:
+		if actual.Valid {
+			return actual.String
+		}
+	case *interface{}:
+		if data, ok := (*actual).([]byte); ok {
+			return string(data)
+		}
+		return *actual
+	}
+	return nil
+}
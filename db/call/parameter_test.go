@@ -0,0 +1,32 @@
+package call
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParameter_Convert(t *testing.T) {
+	type testCase struct {
+		name      string
+		paramType string
+		value     interface{}
+		expect    interface{}
+	}
+
+	testCases := []testCase{
+		{name: "int bytes", paramType: "int", value: []byte("42"), expect: int64(42)},
+		{name: "float bytes", paramType: "float", value: []byte("1.5"), expect: 1.5},
+		{name: "bool bytes", paramType: "bool", value: []byte("1"), expect: true},
+		{name: "time bytes", paramType: "time", value: []byte("2024-03-01 10:20:30"), expect: "2024-03-01T10:20:30Z"},
+		{name: "string bytes", value: []byte("abc"), expect: "abc"},
+		{name: "int value", paramType: "int", value: int64(7), expect: int64(7)},
+		{name: "null", paramType: "int", value: nil, expect: nil},
+		{name: "unconvertible", paramType: "int", value: []byte("n/a"), expect: "n/a"},
+	}
+
+	for _, tc := range testCases {
+		param := &Parameter{Mode: ModeOut, Type: tc.paramType}
+		assert.EqualValues(t, tc.expect, param.convert(tc.value), tc.name)
+	}
+}
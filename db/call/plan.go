@@ -0,0 +1,156 @@
+package call
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/viant/mcp-sqlkit/db/dialect"
+)
+
+const (
+	// KindProcedure invokes a stored procedure.
+	KindProcedure = "procedure"
+	// KindFunction invokes a stored function.
+	KindFunction = "function"
+)
+
+// plan describes statements needed to invoke a routine on a specific driver.
+type plan struct {
+	// setup statements run before the call (MySQL session variables for inout).
+	setup []statement
+	// call is the routine invocation.
+	call statement
+	// queryRows reports whether call produces result sets.
+	queryRows bool
+	// outRow reports whether out values are returned as the first result set row (Postgres).
+	outRow bool
+	// fetch optionally selects out values after the call (MySQL session variables).
+	fetch *statement
+	// outputs holds sql.Out destinations keyed by parameter index.
+	outputs map[int]interface{}
+	// result holds destination of an Oracle function return value.
+	result interface{}
+}
+
+type statement struct {
+	SQL  string
+	Args []interface{}
+}
+
+// newPlan builds driver specific invocation statements.
+func newPlan(driver string, input *Input) (*plan, error) {
+	if input.Name == "" {
+		return nil, fmt.Errorf("routine name was empty")
+	}
+	d := dialect.Of(driver)
+	name := d.QuoteIdentifier(input.Name)
+	isFunction := strings.EqualFold(input.Kind, KindFunction)
+	ret := &plan{outputs: map[int]interface{}{}}
+	placeholders := make([]string, len(input.Parameters))
+	var args []interface{}
+
+	switch d.Driver {
+	case "sqlite":
+		return nil, fmt.Errorf("sqlite does not support stored procedures or functions")
+	case "mysql":
+		for i := range input.Parameters {
+			param := &input.Parameters[i]
+			if !param.isOutput() {
+				args = append(args, param.Value)
+				placeholders[i] = "?"
+				continue
+			}
+			variable := fmt.Sprintf("@sqlkit_p%d", i+1)
+			placeholders[i] = variable
+			value := interface{}(nil)
+			if param.mode() == ModeInOut {
+				value = param.Value
+			}
+			ret.setup = append(ret.setup, statement{SQL: "SET " + variable + " = ?", Args: []interface{}{value}})
+		}
+		if len(ret.setup) > 0 {
+			var variables []string
+			for i := range input.Parameters {
+				if input.Parameters[i].isOutput() {
+					variables = append(variables, placeholders[i])
+				}
+			}
+			ret.fetch = &statement{SQL: "SELECT " + strings.Join(variables, ", ")}
+		}
+		ret.queryRows = true
+		if isFunction {
+			ret.call = statement{SQL: "SELECT " + name + "(" + strings.Join(placeholders, ", ") + ")", Args: args}
+		} else {
+			ret.call = statement{SQL: "CALL " + name + "(" + strings.Join(placeholders, ", ") + ")", Args: args}
+		}
+	case "postgres":
+		var arguments []string
+		for i := range input.Parameters {
+			param := &input.Parameters[i]
+			switch param.mode() {
+			case ModeOut:
+				ret.outRow = true
+				if isFunction {
+					// OUT parameters are not part of a function signature; their values are result columns.
+					continue
+				}
+				arguments = append(arguments, "NULL")
+			default:
+				args = append(args, param.Value)
+				arguments = append(arguments, d.Placeholder(len(args)))
+				if param.mode() == ModeInOut {
+					ret.outRow = true
+				}
+			}
+		}
+		ret.queryRows = true
+		if isFunction {
+			ret.call = statement{SQL: "SELECT * FROM " + name + "(" + strings.Join(arguments, ", ") + ")", Args: args}
+		} else {
+			ret.call = statement{SQL: "CALL " + name + "(" + strings.Join(arguments, ", ") + ")", Args: args}
+		}
+	case "oracle":
+		offset := 0
+		if isFunction {
+			ret.result = (&Parameter{Type: input.ReturnType, Mode: ModeOut}).destination()
+			args = append(args, sql.Out{Dest: ret.result})
+			offset = 1
+		}
+		for i := range input.Parameters {
+			param := &input.Parameters[i]
+			placeholders[i] = d.Placeholder(i + 1 + offset)
+			if !param.isOutput() {
+				args = append(args, param.Value)
+				continue
+			}
+			dest := param.destination()
+			ret.outputs[i] = dest
+			args = append(args, sql.Out{Dest: dest, In: param.mode() == ModeInOut})
+		}
+		invocation := name + "(" + strings.Join(placeholders, ", ") + ")"
+		if isFunction {
+			invocation = d.Placeholder(1) + " := " + invocation
+		}
+		ret.call = statement{SQL: "BEGIN " + invocation + "; END;", Args: args}
+	default:
+		for i := range input.Parameters {
+			param := &input.Parameters[i]
+			placeholders[i] = d.Placeholder(i + 1)
+			if !param.isOutput() {
+				args = append(args, param.Value)
+				continue
+			}
+			dest := param.destination()
+			ret.outputs[i] = dest
+			args = append(args, sql.Out{Dest: dest, In: param.mode() == ModeInOut})
+		}
+		ret.queryRows = len(ret.outputs) == 0
+		if isFunction {
+			ret.call = statement{SQL: "SELECT " + name + "(" + strings.Join(placeholders, ", ") + ")", Args: args}
+		} else {
+			ret.call = statement{SQL: "CALL " + name + "(" + strings.Join(placeholders, ", ") + ")", Args: args}
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,105 @@
+package call
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPlan(t *testing.T) {
+	type testCase struct {
+		name         string
+		driver       string
+		input        *Input
+		expectSQL    string
+		expectSetup  []string
+		expectFetch  string
+		expectArgs   int
+		expectOutRow bool
+	}
+
+	params := []Parameter{{Value: 10}, {Name: "total", Mode: "out", Type: "int"}, {Name: "note", Mode: "inout", Value: "x"}}
+	testCases := []testCase{
+		{
+			name:        "mysql_procedure",
+			driver:      "mysql",
+			input:       &Input{Name: "sales.compute", Parameters: params},
+			expectSQL:   "CALL sales.compute(?, @sqlkit_p2, @sqlkit_p3)",
+			expectSetup: []string{"SET @sqlkit_p2 = ?", "SET @sqlkit_p3 = ?"},
+			expectFetch: "SELECT @sqlkit_p2, @sqlkit_p3",
+			expectArgs:  1,
+		},
+		{
+			name:         "postgres_procedure",
+			driver:       "postgres",
+			input:        &Input{Name: "compute", Parameters: params},
+			expectSQL:    "CALL compute($1, NULL, $2)",
+			expectArgs:   2,
+			expectOutRow: true,
+		},
+		{
+			name:       "postgres_function",
+			driver:     "postgres",
+			input:      &Input{Name: "compute", Kind: KindFunction, Parameters: params[:1]},
+			expectSQL:  "SELECT * FROM compute($1)",
+			expectArgs: 1,
+		},
+		{
+			name:         "postgres_function_out",
+			driver:       "postgres",
+			input:        &Input{Name: "compute", Kind: "Function", Parameters: params},
+			expectSQL:    "SELECT * FROM compute($1, $2)",
+			expectArgs:   2,
+			expectOutRow: true,
+		},
+		{
+			name:       "oracle_procedure",
+			driver:     "oracle",
+			input:      &Input{Name: "compute", Parameters: params},
+			expectSQL:  "BEGIN compute(:1, :2, :3); END;",
+			expectArgs: 3,
+		},
+		{
+			name:       "oracle_function",
+			driver:     "oracle",
+			input:      &Input{Name: "compute", Kind: KindFunction, ReturnType: "float", Parameters: params[:1]},
+			expectSQL:  "BEGIN :1 := compute(:2); END;",
+			expectArgs: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		aPlan, err := newPlan(tc.driver, tc.input)
+		require.NoError(t, err, tc.name)
+		assert.EqualValues(t, tc.expectSQL, aPlan.call.SQL, tc.name)
+		assert.Len(t, aPlan.call.Args, tc.expectArgs, tc.name)
+		assert.EqualValues(t, tc.expectOutRow, aPlan.outRow, tc.name)
+		var setup []string
+		for _, stmt := range aPlan.setup {
+			setup = append(setup, stmt.SQL)
+		}
+		assert.EqualValues(t, tc.expectSetup, setup, tc.name)
+		if tc.expectFetch != "" {
+			require.NotNil(t, aPlan.fetch, tc.name)
+			assert.EqualValues(t, tc.expectFetch, aPlan.fetch.SQL, tc.name)
+		}
+	}
+
+	oraclePlan, err := newPlan("oracle", &Input{Name: "compute", Parameters: params})
+	require.NoError(t, err)
+	out, ok := oraclePlan.call.Args[2].(sql.Out)
+	require.True(t, ok)
+	assert.True(t, out.In)
+	assert.EqualValues(t, "x", dereference(out.Dest))
+
+	_, err = newPlan("sqlite", &Input{Name: "compute"})
+	assert.Error(t, err)
+}
+
+func TestOutRowValues(t *testing.T) {
+	params := []Parameter{{Value: 10}, {Name: "Total", Mode: "out"}, {Mode: "out"}}
+	row := map[string]interface{}{"total": int64(3), "note": "x"}
+	assert.EqualValues(t, map[string]interface{}{"Total": int64(3), "note": "x"}, outRowValues(params, row))
+}
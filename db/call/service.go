@@ -0,0 +1,212 @@
+package call
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/exec"
+	"github.com/viant/mcp-sqlkit/db/query"
+)
+
+// Input defines parameters for invoking a stored procedure or function.
+type Input struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Name of the procedure/function (optionally schema qualified).
+	Name string `json:"name"`
+
+	// Kind of the routine (default procedure).
+	Kind string `json:"kind,omitempty" choice:"procedure" choice:"function"`
+
+	// Parameters in declaration order.
+	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// ReturnType of a function result when bound as OUT parameter (Oracle).
+	ReturnType string `json:"returnType,omitempty" choice:"string" choice:"int" choice:"float" choice:"bool" choice:"time"`
+}
+
+// Output follows the same envelope format used by other DB tools.
+type Output struct {
+	Return     interface{}            `json:"return,omitempty"`
+	Out        map[string]interface{} `json:"out,omitempty"`
+	ResultSets [][]interface{}        `json:"resultSets,omitempty"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error,omitempty"`
+	Connector  string                 `json:"connector,omitempty"`
+}
+
+// Service invokes stored routines.
+type Service struct {
+	connectors *connector.Service
+}
+
+// New creates a call service instance.
+func New(connectors *connector.Service) *Service {
+	return &Service{connectors: connectors}
+}
+
+// Call invokes a routine and collects out parameters and result sets.
+func (s *Service) Call(ctx context.Context, input *Input) *Output {
+	output := &Output{Status: "ok"}
+	if input == nil {
+		input = &Input{}
+	}
+	if err := s.invoke(ctx, input, output); err != nil {
+		output.Status = "error"
+		output.Error = err.Error()
+	}
+	output.Connector = input.Connector
+	return output
+}
+
+func (s *Service) invoke(ctx context.Context, input *Input, output *Output) error {
+	con, err := s.connectors.Connection(ctx, input.Connector)
+	if err != nil {
+		return err
+	}
+	db, err := con.Db(ctx)
+	if err != nil {
+		return err
+	}
+	aPlan, err := newPlan(con.Driver, input)
+	if err != nil {
+		return err
+	}
+	// Session variables and out binds must share a single connection – a transaction guarantees that.
+	return exec.Transact(ctx, db, con.Driver, func(ctx context.Context, executor exec.Executor) error {
+		for _, stmt := range aPlan.setup {
+			if _, err := executor.ExecContext(ctx, stmt.SQL, stmt.Args...); err != nil {
+				return err
+			}
+		}
+		if aPlan.queryRows {
+			rows, err := executor.QueryContext(ctx, aPlan.call.SQL, aPlan.call.Args...)
+			if err != nil {
+				return err
+			}
+			if output.ResultSets, err = readResultSets(rows); err != nil {
+				return err
+			}
+		} else if _, err := executor.ExecContext(ctx, aPlan.call.SQL, aPlan.call.Args...); err != nil {
+			return err
+		}
+
+		out := map[string]interface{}{}
+		for i, dest := range aPlan.outputs {
+			out[input.Parameters[i].key(i+1)] = dereference(dest)
+		}
+		if aPlan.outRow && len(output.ResultSets) > 0 {
+			// Postgres returns out/inout values as a single row: CALL output or function result columns.
+			if row, ok := firstRow(output.ResultSets[0]); ok {
+				for key, value := range outRowValues(input.Parameters, row) {
+					out[key] = value
+				}
+				output.ResultSets = output.ResultSets[1:]
+			}
+		}
+		if aPlan.fetch != nil {
+			values, err := fetchRow(ctx, executor, aPlan.fetch)
+			if err != nil {
+				return err
+			}
+			index := 0
+			for i := range input.Parameters {
+				if input.Parameters[i].isOutput() && index < len(values) {
+					out[input.Parameters[i].key(i+1)] = input.Parameters[i].convert(values[index])
+					index++
+				}
+			}
+		}
+		if len(out) > 0 {
+			output.Out = out
+		}
+		if aPlan.result != nil {
+			output.Return = dereference(aPlan.result)
+		} else if strings.EqualFold(input.Kind, KindFunction) && len(output.ResultSets) == 1 && len(output.ResultSets[0]) == 1 {
+			if row, ok := firstRow(output.ResultSets[0]); ok && len(row) == 1 {
+				for _, value := range row {
+					output.Return = value
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// readResultSets reads all result sets produced by rows.
+func readResultSets(rows *sql.Rows) ([][]interface{}, error) {
+	defer rows.Close()
+	var result [][]interface{}
+	for {
+		data, err := query.ReadAll(rows)
+		if err != nil {
+			return nil, err
+		}
+		if data != nil {
+			result = append(result, data)
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	return result, rows.Err()
+}
+
+// fetchRow returns raw values of the first row; callers convert them to declared parameter types.
+func fetchRow(ctx context.Context, executor exec.Executor, stmt *statement) ([]interface{}, error) {
+	rows, err := executor.QueryContext(ctx, stmt.SQL, stmt.Args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(columns))
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	targets := make([]interface{}, len(columns))
+	for i := range targets {
+		targets[i] = &values[i]
+	}
+	if err = rows.Scan(targets...); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// outRowValues keys out row columns by the matching output parameter (ignoring case);
+// columns without a named parameter keep their column name.
+func outRowValues(params []Parameter, row map[string]interface{}) map[string]interface{} {
+	ret := make(map[string]interface{}, len(row))
+	for column, value := range row {
+		ret[column] = value
+	}
+	for i := range params {
+		param := &params[i]
+		if !param.isOutput() || param.Name == "" {
+			continue
+		}
+		for column, value := range row {
+			if strings.EqualFold(column, param.Name) {
+				delete(ret, column)
+				ret[param.key(i+1)] = value
+				break
+			}
+		}
+	}
+	return ret
+}
+
+func firstRow(rows []interface{}) (map[string]interface{}, bool) {
+	if len(rows) == 0 {
+		return nil, false
+	}
+	row, ok := rows[0].(map[string]interface{})
+	return row, ok
+}
@@ -27,6 +27,7 @@ type Connector struct {
 	DSN         string        `json:"dsn" yaml:"dsn"`
 	Driver      string        `json:"driver" yaml:"driver"`
 	Secrets     *scy.Resource `json:"secrets,omitempty" yaml:"secrets,omitempty" internal:"true"`
+	Pool        *Pool         `json:"pool,omitempty" yaml:"pool,omitempty"`
 	db          *sql.DB       `internal:"true"`
 	mux         sync.RWMutex  `internal:"true"`
 	initialized uint32        `internal:"true"`
@@ -90,6 +91,7 @@ func (c *Connector) Db(ctx context.Context) (*sql.DB, error) {
 	if err != nil {
 		return nil, err
 	}
+	c.Pool.apply(db)
 	c.db = db
 	if atomic.CompareAndSwapUint32(&c.initialized, 0, 1) {
 		go c.ensureActive()
@@ -26,10 +26,15 @@ type ConnectionInput struct {
 	Host      string `json:"host,omitempty" description:"Host"`
 	Port      int    `json:"port,omitempty" description:"Port"`
 	Project   string `json:"project,omitempty" description:"Project"`
-	Db        string `json:"db,omitempty" description:"DB/Dataset"`
+	Db        string `json:"db,omitempty" description:"DB/Dataset, Oracle service name or SQLite file path"`
 	Options   string `json:"options,omitempty" description:"Options"`
 	SecretURL string `json:"secretURL,omitempty" description:"Optional scy secret URL used to resolve connector credentials"`
 	SecretKey string `json:"secretKey,omitempty" description:"Optional scy encryption key for the secret resource"`
+
+	MaxOpenConns           int `json:"maxOpenConns,omitempty" description:"Maximum open connections (optional, default unlimited)"`
+	MaxIdleConns           int `json:"maxIdleConns,omitempty" description:"Maximum idle connections (optional, default 2, negative disables idle connections)"`
+	ConnMaxLifetimeSeconds int `json:"connMaxLifetimeSeconds,omitempty" description:"Maximum connection lifetime in seconds (optional, default unlimited)"`
+	ConnMaxIdleTimeSeconds int `json:"connMaxIdleTimeSeconds,omitempty" description:"Maximum connection idle time in seconds (optional, default unlimited)"`
 }
 
 func (i *ConnectionInput) Init(config *meta.Config) {
@@ -79,6 +84,19 @@ func (i *ConnectionInput) Expand(dsn string) string {
 	return dsn
 }
 
+// Pool returns pool settings or nil when none are set.
+func (i *ConnectionInput) Pool() *Pool {
+	if i == nil || (i.MaxOpenConns == 0 && i.MaxIdleConns == 0 && i.ConnMaxLifetimeSeconds == 0 && i.ConnMaxIdleTimeSeconds == 0) {
+		return nil
+	}
+	return &Pool{
+		MaxOpenConns:           i.MaxOpenConns,
+		MaxIdleConns:           i.MaxIdleConns,
+		ConnMaxLifetimeSeconds: i.ConnMaxLifetimeSeconds,
+		ConnMaxIdleTimeSeconds: i.ConnMaxIdleTimeSeconds,
+	}
+}
+
 func (i *ConnectionInput) SecretResource() *scy.Resource {
 	if i == nil || i.SecretURL == "" {
 		return nil
@@ -156,6 +174,7 @@ func (s *Service) requestConnectorElicit(ctx context.Context, impl client.Operat
 		Driver:  metaInput.Driver,
 		DSN:     metaInput.Expand(metaConfig.DSN),
 		Secrets: metaInput.SecretResource(),
+		Pool:    metaInput.Pool(),
 	}
 	if _, err := s.set(ctx, conn, metaInput.UserName); err != nil {
 		return "", err
@@ -0,0 +1,53 @@
+package connector
+
+import "context"
+
+// SchemaListener is notified when schema objects of a connector change, e.g.
+// after DDL was executed through the toolbox.
+type SchemaListener func(namespace, connector string)
+
+// ConnectorListener is notified when a connector is added to, activated in or
+// removed from a namespace.
+type ConnectorListener func(namespace, connector string)
+
+// OnSchemaChange registers a listener notified after connector schema changes.
+func (c *Manager) OnSchemaChange(listener SchemaListener) {
+	c.listenerMux.Lock()
+	defer c.listenerMux.Unlock()
+	c.schemaListeners = append(c.schemaListeners, listener)
+}
+
+// SchemaChanged notifies registered listeners that the named connector schema
+// changed in the caller's namespace.
+func (s *Service) SchemaChanged(ctx context.Context, connector string) {
+	if s == nil || s.Manager == nil {
+		return
+	}
+	namespace, err := s.Namespace(ctx)
+	if err != nil {
+		return
+	}
+	s.listenerMux.RLock()
+	listeners := s.schemaListeners
+	s.listenerMux.RUnlock()
+	for _, listener := range listeners {
+		listener(namespace, connector)
+	}
+}
+
+// OnConnectorChange registers a listener notified after connectors of a namespace change.
+func (c *Manager) OnConnectorChange(listener ConnectorListener) {
+	c.listenerMux.Lock()
+	defer c.listenerMux.Unlock()
+	c.connectorListeners = append(c.connectorListeners, listener)
+}
+
+// connectorChanged notifies registered listeners that the named connector changed in namespace.
+func (c *Manager) connectorChanged(namespace, connector string) {
+	c.listenerMux.RLock()
+	listeners := c.connectorListeners
+	c.listenerMux.RUnlock()
+	for _, listener := range listeners {
+		listener(namespace, connector)
+	}
+}
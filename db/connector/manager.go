@@ -10,7 +10,9 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 
+	"github.com/viant/afs"
 	"github.com/viant/mcp-sqlkit/auth"
 	"github.com/viant/mcp-sqlkit/db/connector/meta"
 	"github.com/viant/scy"
@@ -24,6 +26,11 @@ type Manager struct {
 	auth       *auth.Service
 	secrets    *scy.Service
 	pending    *PendingSecrets
+	fs         afs.Service
+
+	listenerMux        sync.RWMutex
+	schemaListeners    []SchemaListener
+	connectorListeners []ConnectorListener
 }
 
 func NewManager(cfg *Config, authSvc *auth.Service, secrets *scy.Service) *Manager {
@@ -34,6 +41,7 @@ func NewManager(cfg *Config, authSvc *auth.Service, secrets *scy.Service) *Manag
 		auth:       authSvc,
 		secrets:    secrets,
 		pending:    NewPendingSecrets(),
+		fs:         afs.New(),
 	}
 	mgr.initDefaultConnectors()
 	return mgr
@@ -194,6 +202,9 @@ func (c *Manager) CompletePending(uuid string) error {
 		ns.Connectors.Put(pend.Connector.Name, pend.Connector)
 	}
 	c.pending.Close(uuid)
+	if ns != nil {
+		c.connectorChanged(pend.Namespace, pend.Connector.Name)
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package meta
 import (
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/lib/pq"
+	_ "github.com/sijms/go-ora/v2"
 	"github.com/viant/scy/cred"
 	_ "modernc.org/sqlite"
 	"reflect"
@@ -14,6 +15,8 @@ import (
 )
 
 type (
+	// Config describes how connectors of a driver are built; CredType is nil
+	// for drivers that need no credentials (e.g. sqlite).
 	Config struct {
 		Driver       string
 		DSN          string
@@ -63,5 +66,23 @@ func GetConfigs() []*Config {
 				Host:    "localhost",
 			},
 		},
+		{
+			// Db is the service name, e.g. FREEPDB1 or ORCLPDB1.
+			Driver:   "oracle",
+			DSN:      "oracle://$Username:$Password@${Host}:${Port}/${Db}?${Options}",
+			CredType: reflect.TypeOf(&cred.Basic{}),
+			Defaults: Defaults{
+				Port: 1521,
+				Host: "localhost",
+			},
+		},
+		{
+			// Db is the database file path (or :memory:).
+			Driver: "sqlite",
+			DSN:    "file:${Db}?${Options}",
+			Defaults: Defaults{
+				Options: "_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)",
+			},
+		},
 	}
 }
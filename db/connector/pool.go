@@ -0,0 +1,107 @@
+package connector
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Pool configures the connector database/sql pool; zero values keep Go defaults
+// (unlimited open connections, 2 idle connections, no lifetime limits).
+type Pool struct {
+	MaxOpenConns           int `json:"maxOpenConns,omitempty" yaml:"maxOpenConns,omitempty"`
+	MaxIdleConns           int `json:"maxIdleConns,omitempty" yaml:"maxIdleConns,omitempty"`
+	ConnMaxLifetimeSeconds int `json:"connMaxLifetimeSeconds,omitempty" yaml:"connMaxLifetimeSeconds,omitempty"`
+	ConnMaxIdleTimeSeconds int `json:"connMaxIdleTimeSeconds,omitempty" yaml:"connMaxIdleTimeSeconds,omitempty"`
+}
+
+// apply sets configured limits on db; a negative MaxIdleConns disables idle connections.
+func (p *Pool) apply(db *sql.DB) {
+	if p == nil {
+		return
+	}
+	if p.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(p.MaxOpenConns)
+	}
+	if p.MaxIdleConns != 0 {
+		db.SetMaxIdleConns(p.MaxIdleConns)
+	}
+	if p.ConnMaxLifetimeSeconds > 0 {
+		db.SetConnMaxLifetime(time.Duration(p.ConnMaxLifetimeSeconds) * time.Second)
+	}
+	if p.ConnMaxIdleTimeSeconds > 0 {
+		db.SetConnMaxIdleTime(time.Duration(p.ConnMaxIdleTimeSeconds) * time.Second)
+	}
+}
+
+// PoolStatsInput defines parameters for the dbPoolStats tool.
+type PoolStatsInput struct {
+	Pattern string `json:"pattern,omitempty" description:"Connector name substring (optional)"`
+}
+
+// PoolStatsOutput conveys pool statistics of connectors in the caller's namespace.
+type PoolStatsOutput struct {
+	Data   []*PoolStats `json:"data"`
+	Status string       `json:"status"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// PoolStats reports pool settings and database/sql statistics of a connector;
+// statistics are empty until the pool is opened by first use.
+type PoolStats struct {
+	Connector         string  `json:"connector"`
+	Driver            string  `json:"driver"`
+	Open              bool    `json:"open"`
+	Pool              *Pool   `json:"pool,omitempty"`
+	MaxOpen           int     `json:"maxOpen"`
+	OpenConnections   int     `json:"openConnections"`
+	InUse             int     `json:"inUse"`
+	Idle              int     `json:"idle"`
+	WaitCount         int64   `json:"waitCount"`
+	WaitMs            float64 `json:"waitMs"`
+	MaxIdleClosed     int64   `json:"maxIdleClosed"`
+	MaxIdleTimeClosed int64   `json:"maxIdleTimeClosed"`
+	MaxLifetimeClosed int64   `json:"maxLifetimeClosed"`
+}
+
+// Stats returns pool statistics; ok is false when the pool is not open.
+func (c *Connector) Stats() (sql.DBStats, bool) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	if c.db == nil {
+		return sql.DBStats{}, false
+	}
+	return c.db.Stats(), true
+}
+
+// PoolStats returns pool settings and statistics of connectors visible to the caller.
+func (s *Service) PoolStats(ctx context.Context, input *PoolStatsInput) *PoolStatsOutput {
+	out := &PoolStatsOutput{Status: "ok", Data: []*PoolStats{}}
+	pattern := ""
+	if input != nil {
+		pattern = input.Pattern
+	}
+	for _, conn := range s.List(ctx) {
+		if pattern != "" && !strings.Contains(conn.Name, pattern) {
+			continue
+		}
+		item := &PoolStats{Connector: conn.Name, Driver: conn.Driver, Pool: conn.Pool}
+		if stats, ok := conn.Stats(); ok {
+			item.Open = true
+			item.MaxOpen = stats.MaxOpenConnections
+			item.OpenConnections = stats.OpenConnections
+			item.InUse = stats.InUse
+			item.Idle = stats.Idle
+			item.WaitCount = stats.WaitCount
+			item.WaitMs = float64(stats.WaitDuration.Microseconds()) / 1000
+			item.MaxIdleClosed = stats.MaxIdleClosed
+			item.MaxIdleTimeClosed = stats.MaxIdleTimeClosed
+			item.MaxLifetimeClosed = stats.MaxLifetimeClosed
+		}
+		out.Data = append(out.Data, item)
+	}
+	sort.Slice(out.Data, func(i, j int) bool { return out.Data[i].Connector < out.Data[j].Connector })
+	return out
+}
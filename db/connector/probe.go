@@ -0,0 +1,229 @@
+package connector
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/mcp-sqlkit/db/dsn"
+)
+
+const (
+	// FailureDNS reports a host name that cannot be resolved.
+	FailureDNS = "dns"
+	// FailureRefused reports a host that actively refused the TCP connection.
+	FailureRefused = "tcp_refused"
+	// FailureTimeout reports a connection or query that did not complete in time.
+	FailureTimeout = "timeout"
+	// FailureTLS reports TLS/SSL negotiation or certificate errors.
+	FailureTLS = "tls"
+	// FailureAuth reports rejected credentials.
+	FailureAuth = "auth"
+	// FailureUnknownDatabase reports a missing database, service name, dataset or SQLite file.
+	FailureUnknownDatabase = "unknown_database"
+	// FailureSecret reports a secret that cannot be loaded.
+	FailureSecret = "secret"
+	// FailureUnknown reports any other error.
+	FailureUnknown = "unknown"
+
+	defaultProbeTimeout = 10 * time.Second
+	maxProbeTimeout     = 60 * time.Second
+	maxFailureDetail    = 300
+)
+
+// versionQueries return the server version; drivers without one run SELECT 1.
+var versionQueries = map[string]string{
+	"mysql":    "SELECT VERSION()",
+	"postgres": "SELECT current_setting('server_version')",
+	"oracle":   "SELECT VERSION FROM PRODUCT_COMPONENT_VERSION WHERE PRODUCT LIKE 'Oracle%' AND ROWNUM = 1",
+	"sqlite":   "SELECT sqlite_version()",
+}
+
+// failureHints explain classified failures.
+var failureHints = map[string]string{
+	FailureDNS:             "host name cannot be resolved – check the host",
+	FailureRefused:         "connection refused – check the port and that the server is running",
+	FailureTimeout:         "no response in time – check host, port, firewall or VPN",
+	FailureTLS:             "TLS negotiation failed – check SSL options and certificates",
+	FailureAuth:            "credentials were rejected – update the secret via dbSetConnection",
+	FailureUnknownDatabase: "database, service name, dataset or file does not exist – check db",
+	FailureSecret:          "secret cannot be loaded – provide credentials via dbSetConnection",
+	FailureUnknown:         "connection failed – see detail",
+}
+
+// TestConnectionInput identifies a connector to test.
+type TestConnectionInput struct {
+	Name           string `json:"name" description:"Connector name"`
+	TimeoutSeconds int    `json:"timeoutSeconds,omitempty" description:"Timeout in seconds (default 10, max 60)"`
+}
+
+// TestConnectionOutput conveys the result of the dbTestConnection tool; a failed
+// connection is reported in data, not as a tool error.
+type TestConnectionOutput struct {
+	Data      *ConnectionTest `json:"data,omitempty"`
+	Status    string          `json:"status"`
+	Error     string          `json:"error,omitempty"`
+	Connector string          `json:"connector,omitempty"`
+}
+
+// ConnectionTest reports reachability, latency per step, server version and a classified failure.
+type ConnectionTest struct {
+	Driver        string  `json:"driver"`
+	Host          string  `json:"host,omitempty"`
+	Port          int     `json:"port,omitempty"`
+	State         string  `json:"state,omitempty"`
+	Reachable     bool    `json:"reachable"`
+	Connected     bool    `json:"connected"`
+	DialMs        float64 `json:"dialMs,omitempty"`
+	PingMs        float64 `json:"pingMs,omitempty"`
+	QueryMs       float64 `json:"queryMs,omitempty"`
+	ServerVersion string  `json:"serverVersion,omitempty"`
+	Failure       string  `json:"failure,omitempty"`
+	Hint          string  `json:"hint,omitempty"`
+	Detail        string  `json:"detail,omitempty"`
+}
+
+// fail records a classified failure.
+func (t *ConnectionTest) fail(err error) {
+	t.Failure = classifyFailure(err)
+	t.Hint = failureHints[t.Failure]
+	t.Detail = err.Error()
+	if len(t.Detail) > maxFailureDetail {
+		t.Detail = t.Detail[:maxFailureDetail] + "..."
+	}
+}
+
+// TestConnection dials the connector host, then pings it and runs a version query
+// on its (reused) pool. Pending connectors without secrets are only dialed so that
+// host and port can be validated before credentials are entered.
+func (s *Service) TestConnection(ctx context.Context, input *TestConnectionInput) (*TestConnectionOutput, error) {
+	if input == nil || input.Name == "" {
+		return nil, fmt.Errorf("connector name cannot be empty")
+	}
+	conn, err := s.Connection(ctx, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.Duration(input.TimeoutSeconds) * time.Second
+	switch {
+	case timeout <= 0:
+		timeout = defaultProbeTimeout
+	case timeout > maxProbeTimeout:
+		timeout = maxProbeTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	metaCfg := s.matchMeta(conn.Driver)
+	location := dsn.Parse(conn.Driver, conn.DSN)
+	result := &ConnectionTest{Driver: conn.Driver, Host: location.Host, Port: location.Port, State: "ACTIVE"}
+	if result.Host != "" && result.Port == 0 {
+		result.Port = metaCfg.Defaults.Port
+	}
+	pending := strings.Contains(conn.DSN, "$") && !s.secretExists(ctx, conn, metaCfg.CredType)
+	if pending {
+		result.State = "PENDING_SECRET"
+	}
+	out := &TestConnectionOutput{Status: "ok", Connector: input.Name, Data: result}
+	if result.Host != "" && result.Port != 0 {
+		started := time.Now()
+		dialer := &net.Dialer{}
+		tcp, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(result.Host, strconv.Itoa(result.Port)))
+		result.DialMs = elapsedMs(started)
+		if err != nil {
+			result.fail(err)
+			return out, nil
+		}
+		_ = tcp.Close()
+		result.Reachable = true
+	}
+	if pending {
+		result.Hint = "secret not provided yet – complete dbSetConnection to connect"
+		return out, nil
+	}
+
+	db, err := conn.Db(ctx)
+	if err != nil {
+		result.fail(err)
+		return out, nil
+	}
+	started := time.Now()
+	err = db.PingContext(ctx)
+	result.PingMs = elapsedMs(started)
+	if err != nil {
+		result.fail(err)
+		return out, nil
+	}
+	result.Reachable = true
+	driver := dialect.Of(conn.Driver).Driver
+	SQL, ok := versionQueries[driver]
+	if !ok {
+		SQL = "SELECT 1"
+	}
+	var version sql.NullString
+	started = time.Now()
+	err = db.QueryRowContext(ctx, SQL).Scan(&version)
+	result.QueryMs = elapsedMs(started)
+	if err != nil {
+		result.fail(err)
+		return out, nil
+	}
+	if ok {
+		result.ServerVersion = version.String
+	}
+	result.Connected = true
+	return out, nil
+}
+
+// classifyFailure maps network and driver errors to a failure category.
+func classifyFailure(err error) string {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return FailureDNS
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return FailureRefused
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return FailureTimeout
+	}
+	message := strings.ToLower(err.Error())
+	contains := func(fragments ...string) bool {
+		for _, fragment := range fragments {
+			if strings.Contains(message, fragment) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case contains("no such host", "server misbehaving", "name resolution"):
+		return FailureDNS
+	case contains("connection refused"):
+		return FailureRefused
+	case contains("i/o timeout", "deadline exceeded", "timed out"):
+		return FailureTimeout
+	case contains("tls", "x509", "certificate", "ssl"):
+		return FailureTLS
+	case contains("access denied", "password authentication failed", "ora-01017", "authentication failed", "invalid credentials", "error 1045"):
+		return FailureAuth
+	case contains("unknown database", "error 1049", "ora-12514", "unable to open database", "notfound", "not found: dataset") ||
+		(contains("database") && contains("does not exist")):
+		return FailureUnknownDatabase
+	case contains("secret", "blowfish", "failed to load"):
+		return FailureSecret
+	}
+	return FailureUnknown
+}
+
+func elapsedMs(started time.Time) float64 {
+	return float64(time.Since(started).Microseconds()) / 1000
+}
@@ -1,16 +1,148 @@
 package connector
 
-import "context"
+import (
+	"context"
+	"fmt"
 
-// Remove deletes a connector from the caller's namespace.
-func (s *Service) Remove(ctx context.Context, name string) {
+	"github.com/google/uuid"
+	"github.com/viant/jsonrpc"
+	"github.com/viant/mcp-protocol/schema"
+)
+
+// RemoveInput identifies a connector to remove from the caller's namespace.
+type RemoveInput struct {
+	Name         string `json:"name" description:"Connector name"`
+	DeleteSecret bool   `json:"deleteSecret,omitempty" description:"Delete the stored connector secret"`
+}
+
+// RemoveOutput conveys the result of the dbRemoveConnection tool.
+type RemoveOutput struct {
+	Status           string `json:"status"`
+	Error            string `json:"error,omitempty"`
+	Connector        string `json:"connector,omitempty"`
+	SecretDeleted    bool   `json:"secretDeleted,omitempty"`
+	PendingCancelled int    `json:"pendingCancelled,omitempty"`
+}
+
+// Remove deletes a connector from the caller's namespace and closes its pool; it returns
+// the removed connector or nil when none was registered.
+func (s *Service) Remove(ctx context.Context, name string) *Connector {
 	namespace, err := s.auth.Namespace(ctx)
 	if err != nil {
-		return
+		return nil
 	}
 	ns, ok := s.namespace.Get(namespace)
 	if !ok {
-		return
+		return nil
+	}
+	connector, ok := ns.Connectors.Get(name)
+	if !ok {
+		return nil
 	}
 	ns.Connectors.Delete(name)
+	_ = connector.Close()
+	s.connectorChanged(namespace, name)
+	return connector
+}
+
+// RemoveConnection removes a connector after MCP Elicit confirmation (when the
+// client supports it), cancels its pending secret entries and optionally deletes
+// its stored secret.
+func (s *Service) RemoveConnection(ctx context.Context, input *RemoveInput) (*RemoveOutput, error) {
+	if input == nil || input.Name == "" {
+		return nil, fmt.Errorf("connector name cannot be empty")
+	}
+	namespace, err := s.auth.Namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ns, ok := s.namespace.Get(namespace)
+	if !ok {
+		return nil, fmt.Errorf("connector %v not found", input.Name)
+	}
+	connector, ok := ns.Connectors.Get(input.Name)
+	if !ok {
+		return nil, fmt.Errorf("connector %v not found", input.Name)
+	}
+	if s.mcpClient != nil && s.mcpClient.Implements(schema.MethodElicitationCreate) {
+		if err = s.confirmRemove(ctx, connector, input); err != nil {
+			return nil, err
+		}
+	}
+	secretURL := ""
+	if input.DeleteSecret && connector.Secrets != nil && connector.Secrets.URL != "" {
+		resource := *connector.Secrets
+		if err = normalizeSecretResourceURL(&resource); err != nil {
+			return nil, err
+		}
+		secretURL = resource.URL
+		if other := s.secretOwner(secretURL, connector); other != "" {
+			return nil, fmt.Errorf("secret %v is shared with connector %v; remove without deleteSecret", secretURL, other)
+		}
+	}
+
+	out := &RemoveOutput{Status: "ok", Connector: input.Name}
+	for _, pend := range s.pending.Values() {
+		if pend.Namespace != namespace || pend.Connector == nil || pend.Connector.Name != input.Name {
+			continue
+		}
+		if err = s.CancelPending(ctx, pend.UUID); err == nil {
+			out.PendingCancelled++
+		}
+	}
+	s.Remove(ctx, input.Name)
+	if secretURL == "" {
+		return out, nil
+	}
+	if exists, _ := s.fs.Exists(ctx, secretURL); exists {
+		if err = s.fs.Delete(ctx, secretURL); err != nil {
+			return nil, fmt.Errorf("connector %v removed but failed to delete secret %v: %w", input.Name, secretURL, err)
+		}
+		out.SecretDeleted = true
+	}
+	return out, nil
+}
+
+// confirmRemove asks the user to confirm removal; the form also lets the user
+// choose whether the stored secret is deleted.
+func (s *Service) confirmRemove(ctx context.Context, connector *Connector, input *RemoveInput) error {
+	properties := map[string]interface{}{
+		"deleteSecret": map[string]interface{}{
+			"type":        "boolean",
+			"description": "Delete the stored connector secret",
+			"default":     input.DeleteSecret,
+		},
+	}
+	result, rpcErr := s.mcpClient.Elicit(ctx, &jsonrpc.TypedRequest[*schema.ElicitRequest]{Request: &schema.ElicitRequest{
+		Params: schema.ElicitRequestParams{
+			ElicitationId:   uuid.New().String(),
+			Message:         fmt.Sprintf("Remove %v connector %v (%v)?", connector.Driver, connector.Name, connector.DSN),
+			RequestedSchema: schema.ElicitRequestParamsRequestedSchema{Type: "object", Properties: properties},
+		}}})
+	if rpcErr != nil {
+		return fmt.Errorf("failed to confirm removing connector %v: %v", connector.Name, rpcErr.Message)
+	}
+	if result == nil || result.Action != schema.ElicitResultActionAccept {
+		return fmt.Errorf("user declined removing connector %v", connector.Name)
+	}
+	if value, ok := result.Content["deleteSecret"].(bool); ok {
+		input.DeleteSecret = value
+	}
+	return nil
+}
+
+// secretOwner returns the name of another connector, in any namespace, that uses the secret URL.
+func (s *Service) secretOwner(secretURL string, except *Connector) string {
+	for _, ns := range s.namespace.Values() {
+		for _, candidate := range ns.Connectors.Values() {
+			if candidate == except || candidate.Secrets == nil {
+				continue
+			}
+			resource := *candidate.Secrets
+			if normalizeSecretResourceURL(&resource) == nil && resource.URL == secretURL {
+				return candidate.Name
+			}
+		}
+	}
+	return ""
 }
@@ -99,7 +99,7 @@ func (s *Service) AddConnection(ctx context.Context, input *ConnectionInput) (*A
 		return nil, err
 	}
 	dsn := input.Expand(metaCfg.DSN)
-	conn := &Connector{Name: input.Name, Driver: input.Driver, DSN: dsn, Secrets: input.SecretResource()}
+	conn := &Connector{Name: input.Name, Driver: input.Driver, DSN: dsn, Secrets: input.SecretResource(), Pool: input.Pool()}
 	return s.set(ctx, conn, input.UserName)
 }
 
@@ -243,6 +243,10 @@ func (s *Service) requestConnectorForm(ctx context.Context, impl client.Operatio
 	if err := metaInput.Validate(metaCfg); err != nil {
 		return nil, err
 	}
-	conn := &Connector{Name: metaInput.Name, Driver: metaInput.Driver, DSN: metaInput.Expand(metaCfg.DSN), Secrets: metaInput.SecretResource()}
+	conn := &Connector{Name: metaInput.Name, Driver: metaInput.Driver, DSN: metaInput.Expand(metaCfg.DSN), Secrets: metaInput.SecretResource(), Pool: metaInput.Pool()}
+	if conn.Pool == nil {
+		// pool settings are not part of the required form – keep the ones passed to dbSetConnection
+		conn.Pool = initial.Pool()
+	}
 	return s.set(ctx, conn, metaInput.UserName)
 }
@@ -38,9 +38,15 @@ func (s *Service) set(ctx context.Context, connector *Connector, userName string
 	pend.UserName = userName
 	pend.MCP = s.mcpClient
 	connector.secrets = s.secrets
+	previous, _ := pend.NS.Connectors.Get(connector.Name)
 	pend.NS.Connectors.Put(connector.Name, connector)
+	if previous != nil && previous != connector {
+		// a replaced connector may use other credentials or pool settings
+		_ = previous.Close()
+	}
+	s.connectorChanged(pend.Namespace, connector.Name)
 
-	if s.secretExists(ctx, connector, pend.CredType) {
+	if pend.CredType == nil || s.secretExists(ctx, connector, pend.CredType) {
 		s.pending.Delete(pend.UUID)
 		return &AddOutput{Status: "ok", Connector: connector.Name}, nil
 	}
@@ -102,12 +108,9 @@ func (s *Service) GeneratePendingSecret(ctx context.Context, connector *Connecto
 		s.namespace.Put(namespace, ns)
 	}
 
-	// Determine credential type for this driver.
+	// Determine credential type for this driver; nil when the driver needs none.
 	metaCfg := s.matchMeta(connector.Driver)
 	credType := metaCfg.CredType
-	if credType == nil || credType.Kind() == reflect.Invalid {
-		credType = reflect.TypeOf(cred.Basic{})
-	}
 
 	if metaCfg.CredType == reflect.TypeOf(&cred.Basic{}) && (connector.Secrets == nil || connector.Secrets.URL == "") {
 		connector.Secrets = scy.NewResource("", s.defaultSecretURL(namespace, connector), "blowfish://default")
@@ -0,0 +1,100 @@
+// Package dialect captures the small set of SQL syntax differences between the
+// drivers supported by the connector service (see connector/meta.GetConfigs).
+// It is intentionally minimal: identifier quoting, bind placeholders and
+// transaction support are enough for the statement builders used by tools.
+package dialect
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Dialect describes driver specific SQL syntax.
+type Dialect struct {
+	// Driver is the database/sql driver name, e.g. mysql, postgres.
+	Driver string
+	// Quote is the identifier quote character.
+	Quote byte
+	// Transactional reports whether the driver supports database/sql transactions.
+	Transactional bool
+	// placeholder returns bind placeholder for 1-based position.
+	placeholder func(position int) string
+}
+
+// Placeholder returns bind placeholder for the 1-based parameter position.
+func (d *Dialect) Placeholder(position int) string {
+	if d.placeholder == nil {
+		return "?"
+	}
+	return d.placeholder(position)
+}
+
+// QuoteIdentifier quotes a possibly qualified (dotted) identifier when any of
+// its parts is not a plain SQL identifier.
+func (d *Dialect) QuoteIdentifier(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if isPlainIdentifier(part) {
+			continue
+		}
+		quote := string(d.Quote)
+		parts[i] = quote + strings.ReplaceAll(part, quote, quote+quote) + quote
+	}
+	return strings.Join(parts, ".")
+}
+
+// Is reports whether dialect matches any of the supplied driver names.
+func (d *Dialect) Is(drivers ...string) bool {
+	for _, driver := range drivers {
+		if d.Driver == driver {
+			return true
+		}
+	}
+	return false
+}
+
+func isPlainIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func questionMark(int) string { return "?" }
+
+var dialects = map[string]*Dialect{
+	"mysql":    {Driver: "mysql", Quote: '`', Transactional: true, placeholder: questionMark},
+	"postgres": {Driver: "postgres", Quote: '"', Transactional: true, placeholder: func(position int) string { return "$" + strconv.Itoa(position) }},
+	"sqlite":   {Driver: "sqlite", Quote: '"', Transactional: true, placeholder: questionMark},
+	"oracle":   {Driver: "oracle", Quote: '"', Transactional: true, placeholder: func(position int) string { return ":" + strconv.Itoa(position) }},
+	"bigquery": {Driver: "bigquery", Quote: '`', Transactional: false, placeholder: questionMark},
+}
+
+// aliases maps alternative driver registrations to canonical names.
+var aliases = map[string]string{
+	"pgx":     "postgres",
+	"pq":      "postgres",
+	"sqlite3": "sqlite",
+	"godror":  "oracle",
+}
+
+// Of returns dialect for the driver name, falling back to an ANSI-like
+// dialect with `?` placeholders for unknown drivers.
+func Of(driver string) *Dialect {
+	name := strings.ToLower(driver)
+	if alias, ok := aliases[name]; ok {
+		name = alias
+	}
+	if ret, ok := dialects[name]; ok {
+		return ret
+	}
+	return &Dialect{Driver: name, Quote: '"', Transactional: true, placeholder: questionMark}
+}
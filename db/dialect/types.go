@@ -0,0 +1,152 @@
+package dialect
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Type represents a portable column type family used when generating DDL.
+type Type int
+
+const (
+	// TypeText represents character data.
+	TypeText Type = iota
+	// TypeInteger represents whole numbers.
+	TypeInteger
+	// TypeFloat represents floating point numbers.
+	TypeFloat
+	// TypeBoolean represents true/false values.
+	TypeBoolean
+	// TypeTimestamp represents date and time values.
+	TypeTimestamp
+	// TypeDecimal represents exact numeric values with precision and scale.
+	TypeDecimal
+	// TypeDate represents calendar dates without time.
+	TypeDate
+	// TypeBinary represents raw bytes.
+	TypeBinary
+)
+
+// String returns portable type family name.
+func (t Type) String() string {
+	switch t {
+	case TypeInteger:
+		return "integer"
+	case TypeFloat:
+		return "float"
+	case TypeBoolean:
+		return "boolean"
+	case TypeTimestamp:
+		return "timestamp"
+	case TypeDecimal:
+		return "decimal"
+	case TypeDate:
+		return "date"
+	case TypeBinary:
+		return "binary"
+	}
+	return "text"
+}
+
+var typeNames = map[string]map[Type]string{
+	"mysql":    {TypeText: "TEXT", TypeInteger: "BIGINT", TypeFloat: "DOUBLE", TypeBoolean: "BOOLEAN", TypeTimestamp: "DATETIME", TypeDecimal: "DECIMAL", TypeDate: "DATE", TypeBinary: "LONGBLOB"},
+	"postgres": {TypeText: "TEXT", TypeInteger: "BIGINT", TypeFloat: "DOUBLE PRECISION", TypeBoolean: "BOOLEAN", TypeTimestamp: "TIMESTAMP", TypeDecimal: "NUMERIC", TypeDate: "DATE", TypeBinary: "BYTEA"},
+	"sqlite":   {TypeText: "TEXT", TypeInteger: "INTEGER", TypeFloat: "REAL", TypeBoolean: "BOOLEAN", TypeTimestamp: "TIMESTAMP", TypeDecimal: "NUMERIC", TypeDate: "DATE", TypeBinary: "BLOB"},
+	"oracle":   {TypeText: "VARCHAR2(4000)", TypeInteger: "NUMBER(19)", TypeFloat: "BINARY_DOUBLE", TypeBoolean: "NUMBER(1)", TypeTimestamp: "TIMESTAMP", TypeDecimal: "NUMBER", TypeDate: "DATE", TypeBinary: "BLOB"},
+	"bigquery": {TypeText: "STRING", TypeInteger: "INT64", TypeFloat: "FLOAT64", TypeBoolean: "BOOL", TypeTimestamp: "TIMESTAMP", TypeDecimal: "NUMERIC", TypeDate: "DATE", TypeBinary: "BYTES"},
+}
+
+// TypeName returns driver specific column type for the portable type family.
+func (d *Dialect) TypeName(t Type) string {
+	if names, ok := typeNames[d.Driver]; ok {
+		return names[t]
+	}
+	return map[Type]string{TypeText: "VARCHAR(4000)", TypeInteger: "BIGINT", TypeFloat: "DOUBLE PRECISION", TypeBoolean: "BOOLEAN", TypeTimestamp: "TIMESTAMP", TypeDecimal: "DECIMAL", TypeDate: "DATE", TypeBinary: "BLOB"}[t]
+}
+
+// TypeDeclaration returns driver specific column type for the portable type
+// family, keeping text length and decimal precision/scale where the driver supports them.
+func (d *Dialect) TypeDeclaration(t Type, size ...int) string {
+	switch {
+	case t == TypeText && len(size) > 0 && size[0] > 0:
+		switch d.Driver {
+		case "sqlite", "bigquery":
+		case "oracle":
+			if size[0] <= 4000 {
+				return fmt.Sprintf("VARCHAR2(%d)", size[0])
+			}
+			return "CLOB"
+		case "mysql":
+			if size[0] <= 16383 {
+				return fmt.Sprintf("VARCHAR(%d)", size[0])
+			}
+		default:
+			return fmt.Sprintf("VARCHAR(%d)", size[0])
+		}
+	case t == TypeDecimal && len(size) > 0 && size[0] > 0 && d.Driver != "sqlite":
+		scale := 0
+		if len(size) > 1 {
+			scale = size[1]
+		}
+		return fmt.Sprintf("%v(%d,%d)", d.TypeName(t), size[0], scale)
+	}
+	return d.TypeName(t)
+}
+
+var integerTypes = map[string]bool{
+	"INT": true, "INTEGER": true, "BIGINT": true, "SMALLINT": true, "TINYINT": true, "MEDIUMINT": true, "BYTEINT": true,
+	"INT2": true, "INT4": true, "INT8": true, "INT64": true, "SERIAL": true, "SMALLSERIAL": true, "BIGSERIAL": true,
+}
+
+// ParseType classifies a driver type declaration (e.g. "varchar(255)",
+// "NUMBER(10,2)", "timestamp without time zone") into a portable type family
+// and its size arguments.
+func ParseType(declaration string) (Type, []int) {
+	name := strings.ToUpper(strings.TrimSpace(declaration))
+	var size []int
+	if open := strings.Index(name, "("); open != -1 {
+		closing := strings.Index(name[open:], ")")
+		if closing == -1 {
+			closing = len(name) - open
+		}
+		for _, item := range strings.Split(name[open+1:open+closing], ",") {
+			// e.g. VARCHAR2(100 BYTE)
+			if fields := strings.Fields(item); len(fields) > 0 {
+				item = fields[0]
+			}
+			if value, err := strconv.Atoi(item); err == nil {
+				size = append(size, value)
+			}
+		}
+		rest := ""
+		if open+closing < len(name) {
+			rest = name[open+closing+1:]
+		}
+		name = strings.TrimSpace(name[:open]) + rest
+	}
+	// e.g. BIGINT UNSIGNED
+	name = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(name), "UNSIGNED"))
+	switch {
+	case name == "NUMBER":
+		if len(size) == 1 || (len(size) == 2 && size[1] == 0) {
+			return TypeInteger, nil
+		}
+		return TypeDecimal, size
+	case integerTypes[name]:
+		return TypeInteger, nil
+	case strings.Contains(name, "DEC") || strings.Contains(name, "NUMERIC") || name == "MONEY":
+		return TypeDecimal, size
+	case strings.Contains(name, "FLOAT") || strings.Contains(name, "DOUBLE") || name == "REAL":
+		return TypeFloat, nil
+	case strings.HasPrefix(name, "BOOL") || name == "BIT":
+		return TypeBoolean, nil
+	case strings.HasPrefix(name, "TIMESTAMP") || strings.HasPrefix(name, "DATETIME") || strings.HasPrefix(name, "TIME"):
+		return TypeTimestamp, nil
+	case name == "DATE":
+		return TypeDate, nil
+	case strings.Contains(name, "BLOB") || strings.Contains(name, "BINARY") || name == "BYTEA" || name == "BYTES" || strings.HasSuffix(name, "RAW"):
+		return TypeBinary, nil
+	}
+	return TypeText, size
+}
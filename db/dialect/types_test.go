@@ -0,0 +1,50 @@
+package dialect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseType(t *testing.T) {
+	type testCase struct {
+		declaration string
+		expect      Type
+		size        []int
+	}
+	testCases := []testCase{
+		{declaration: "varchar(255)", expect: TypeText, size: []int{255}},
+		{declaration: "VARCHAR2(100 BYTE)", expect: TypeText, size: []int{100}},
+		{declaration: "character varying", expect: TypeText},
+		{declaration: "bigint unsigned", expect: TypeInteger},
+		{declaration: "INT64", expect: TypeInteger},
+		{declaration: "NUMBER(10)", expect: TypeInteger},
+		{declaration: "NUMBER(10,2)", expect: TypeDecimal, size: []int{10, 2}},
+		{declaration: "decimal(12,4)", expect: TypeDecimal, size: []int{12, 4}},
+		{declaration: "double precision", expect: TypeFloat},
+		{declaration: "BINARY_DOUBLE", expect: TypeFloat},
+		{declaration: "boolean", expect: TypeBoolean},
+		{declaration: "timestamp(6) with time zone", expect: TypeTimestamp, size: []int{6}},
+		{declaration: "date", expect: TypeDate},
+		{declaration: "bytea", expect: TypeBinary},
+		{declaration: "interval", expect: TypeText},
+		{declaration: "point", expect: TypeText},
+	}
+	for _, tc := range testCases {
+		actual, size := ParseType(tc.declaration)
+		assert.EqualValues(t, tc.expect, actual, tc.declaration)
+		if tc.expect == TypeText || tc.expect == TypeDecimal {
+			assert.EqualValues(t, tc.size, size, tc.declaration)
+		}
+	}
+}
+
+func TestDialect_TypeDeclaration(t *testing.T) {
+	assert.Equal(t, "VARCHAR(64)", Of("postgres").TypeDeclaration(TypeText, 64))
+	assert.Equal(t, "VARCHAR2(64)", Of("oracle").TypeDeclaration(TypeText, 64))
+	assert.Equal(t, "CLOB", Of("oracle").TypeDeclaration(TypeText, 8000))
+	assert.Equal(t, "STRING", Of("bigquery").TypeDeclaration(TypeText, 64))
+	assert.Equal(t, "DECIMAL(10,2)", Of("mysql").TypeDeclaration(TypeDecimal, 10, 2))
+	assert.Equal(t, "NUMERIC", Of("sqlite").TypeDeclaration(TypeDecimal, 10, 2))
+	assert.Equal(t, "BIGINT", Of("mysql").TypeDeclaration(TypeInteger))
+}
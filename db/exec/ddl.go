@@ -0,0 +1,10 @@
+package exec
+
+import "regexp"
+
+var ddlExpr = regexp.MustCompile(`(?is)^\s*(CREATE|ALTER|DROP|RENAME|TRUNCATE|COMMENT)\b`)
+
+// isDDL reports whether statement changes schema objects.
+func isDDL(query string) bool {
+	return ddlExpr.MatchString(stripLiterals(query))
+}
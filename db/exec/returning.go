@@ -0,0 +1,105 @@
+package exec
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	returningExpr = regexp.MustCompile(`(?is)\bRETURNING\b`)
+	intoExpr      = regexp.MustCompile(`(?i)^INTO\b`)
+	blockExpr     = regexp.MustCompile(`(?is)^\s*(BEGIN|DECLARE)\b`)
+	dollarTagExpr = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+)
+
+// hasReturning reports whether a DML statement contains a RETURNING clause outside
+// of string literals, dollar-quoted bodies and comments; DDL and PL/SQL blocks never
+// produce rows, even when routine bodies use RETURNING.
+func hasReturning(query string) bool {
+	text, ok := dmlText(query)
+	return ok && returningExpr.MatchString(text)
+}
+
+// dmlText returns the statement without literals and comments; ok is false for DDL and PL/SQL blocks.
+func dmlText(query string) (string, bool) {
+	text := stripLiterals(query)
+	return text, !ddlExpr.MatchString(text) && !blockExpr.MatchString(text)
+}
+
+// isReturningInto reports Oracle style RETURNING ... INTO clause which binds
+// values to OUT parameters rather than producing a result set. Only INTO
+// directly after the RETURNING column list counts: a closing parenthesis ends
+// the clause, so INSERT INTO after a Postgres data-modifying CTE is not matched.
+func isReturningInto(query string) bool {
+	text, ok := dmlText(query)
+	if !ok {
+		return false
+	}
+	for _, loc := range returningExpr.FindAllStringIndex(text, -1) {
+		depth := 0
+	scan:
+		for i := loc[1]; i < len(text); i++ {
+			switch c := text[i]; {
+			case c == '(':
+				depth++
+			case c == ')':
+				if depth == 0 {
+					break scan
+				}
+				depth--
+			case c == ';':
+				break scan
+			case depth == 0 && !isWordByte(text[i-1]) && intoExpr.MatchString(text[i:]):
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// stripLiterals removes quoted literals, Postgres dollar-quoted bodies and comments from a SQL statement.
+func stripLiterals(query string) string {
+	var sb strings.Builder
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			sb.WriteByte(' ')
+		case c == '$' && dollarTagExpr.MatchString(query[i:]):
+			tag := dollarTagExpr.FindString(query[i:])
+			if end := strings.Index(query[i+len(tag):], tag); end != -1 {
+				i += len(tag) + end + len(tag) - 1
+			} else {
+				i = len(query)
+			}
+			sb.WriteByte(' ')
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			if end := strings.IndexByte(query[i:], '\n'); end != -1 {
+				i += end
+			} else {
+				i = len(query)
+			}
+			sb.WriteByte(' ')
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			if end := strings.Index(query[i+2:], "*/"); end != -1 {
+				i += end + 3
+			} else {
+				i = len(query)
+			}
+			sb.WriteByte(' ')
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
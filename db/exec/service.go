@@ -2,23 +2,28 @@ package exec
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/viant/mcp-protocol/client"
 	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/query"
 )
 
 type Input struct {
 	Query      string
 	Connector  string
 	Parameters []interface{}
+	// Returning forces reading rows produced by the statement; RETURNING clauses are detected automatically.
+	Returning bool `json:",omitempty"`
 }
 
 type Output struct {
-	RowsAffected int64  `json:"rowsAffected,omitempty"`
-	LastInsertId int64  `json:"LastInsertId,omitempty"`
-	Status       string `json:"status"`
-	Error        string `json:"error,omitempty"`
-	Connector    string `json:",omitempty"`
+	RowsAffected int64         `json:"rowsAffected,omitempty"`
+	LastInsertId int64         `json:"LastInsertId,omitempty"`
+	Data         []interface{} `json:"data,omitempty"`
+	Status       string        `json:"status"`
+	Error        string        `json:"error,omitempty"`
+	Connector    string        `json:",omitempty"`
 }
 
 type Service struct {
@@ -41,12 +46,37 @@ func (r *Service) execute(ctx context.Context, input *Input, output *Output) err
 	if err != nil {
 		return err
 	}
+	if err = r.run(ctx, con, input, output); err != nil {
+		return err
+	}
+	if isDDL(input.Query) {
+		r.connectors.SchemaChanged(ctx, con.Name)
+	}
+	return nil
+}
+
+func (r *Service) run(ctx context.Context, con *connector.Connector, input *Input, output *Output) error {
 	db, err := con.Db(ctx)
 	if err != nil {
 		return err
 	}
 
-	result, err := db.ExecContext(ctx, input.Query, input.Parameters)
+	if input.Returning || hasReturning(input.Query) {
+		if isReturningInto(input.Query) {
+			return fmt.Errorf("RETURNING ... INTO binds OUT parameters and cannot produce rows; use RETURNING without INTO or dbCall")
+		}
+		rows, err := db.QueryContext(ctx, input.Query, input.Parameters...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		if output.Data, err = query.ReadAll(rows); err != nil {
+			return err
+		}
+		output.RowsAffected = int64(len(output.Data))
+		return nil
+	}
+	result, err := db.ExecContext(ctx, input.Query, input.Parameters...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,95 @@
+package exec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite" // register SQLite driver
+
+	"github.com/viant/mcp-sqlkit/auth"
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/policy"
+	"github.com/viant/scy"
+)
+
+func TestHasReturning(t *testing.T) {
+	type testCase struct {
+		name   string
+		query  string
+		expect bool
+		into   bool
+	}
+
+	testCases := []testCase{
+		{name: "plain", query: "UPDATE t SET a = 1", expect: false},
+		{name: "returning", query: "INSERT INTO t(a) VALUES (1) RETURNING id", expect: true},
+		{name: "literal", query: "UPDATE t SET note = 'returning soon'", expect: false},
+		{name: "comment", query: "DELETE FROM t -- returning\n", expect: false},
+		{name: "oracle_into", query: "UPDATE t SET a = 1 RETURNING a INTO :1", expect: true, into: true},
+		{name: "oracle_into_expr", query: "UPDATE t SET a = 1 RETURNING NVL(a, 0), b INTO :1, :2", expect: true, into: true},
+		{name: "postgres_cte", query: "WITH d AS (DELETE FROM t RETURNING *) INSERT INTO archive SELECT * FROM d", expect: true},
+		{name: "column_named_into", query: "INSERT INTO t(a) VALUES (1) RETURNING into_date", expect: true},
+		{name: "dollar_quoted", query: "SELECT $$ RETURNING a INTO b $$, $1", expect: false},
+		{name: "postgres_function", query: "CREATE FUNCTION f() RETURNS int AS $body$ DECLARE v int; BEGIN INSERT INTO t(a) VALUES (1) RETURNING id INTO v; RETURN v; END $body$ LANGUAGE plpgsql", expect: false},
+		{name: "oracle_trigger", query: "CREATE OR REPLACE TRIGGER t_bi BEFORE INSERT ON t FOR EACH ROW BEGIN UPDATE s SET n = n + 1 RETURNING n INTO :new.id; END;", expect: false},
+		{name: "plsql_block", query: "BEGIN UPDATE t SET a = 1 RETURNING a INTO :1; END;", expect: false},
+	}
+
+	for _, tc := range testCases {
+		assert.EqualValues(t, tc.expect, hasReturning(tc.query), tc.name)
+		assert.EqualValues(t, tc.into, isReturningInto(tc.query), tc.name)
+	}
+}
+
+func TestIsDDL(t *testing.T) {
+	type testCase struct {
+		name   string
+		query  string
+		expect bool
+	}
+
+	testCases := []testCase{
+		{name: "create", query: "CREATE TABLE t(id INT)", expect: true},
+		{name: "alter", query: "  alter table t add column a int", expect: true},
+		{name: "drop", query: "DROP VIEW v", expect: true},
+		{name: "comment", query: "-- add index\nCREATE INDEX i ON t(a)", expect: true},
+		{name: "dml", query: "UPDATE t SET a = 'create'", expect: false},
+		{name: "insert", query: "INSERT INTO t(a) VALUES ('drop')", expect: false},
+		{name: "dollar_quoted", query: "SELECT $$\nCREATE TABLE x(id INT)$$", expect: false},
+	}
+
+	for _, tc := range testCases {
+		assert.EqualValues(t, tc.expect, isDDL(tc.query), tc.name)
+	}
+}
+
+func TestService_ExecuteReturning(t *testing.T) {
+	cfg := &connector.Config{}
+	mgr := connector.NewManager(cfg, auth.New(&policy.Policy{}), scy.New())
+	connSvc := connector.NewService(mgr, nil)
+	service := New(connSvc)
+
+	ctx := context.Background()
+	conn := &connector.Connector{Name: "testConn", Driver: "sqlite", DSN: "file:execdb?mode=memory&cache=shared"}
+	pend, err := connSvc.GeneratePendingSecret(ctx, conn)
+	require.NoError(t, err)
+	pend.NS.Connectors.Put(conn.Name, conn)
+
+	out := service.Execute(ctx, &Input{Connector: "testConn", Query: "CREATE TABLE items(id INTEGER PRIMARY KEY, name TEXT)"})
+	require.Equal(t, "ok", out.Status, out.Error)
+
+	out = service.Execute(ctx, &Input{Connector: "testConn", Query: "INSERT INTO items(name) VALUES (?), (?) RETURNING id, name", Parameters: []interface{}{"a", "b"}})
+	require.Equal(t, "ok", out.Status, out.Error)
+	assert.EqualValues(t, 2, out.RowsAffected)
+	require.Len(t, out.Data, 2)
+	row, ok := out.Data[1].(map[string]interface{})
+	require.True(t, ok)
+	assert.EqualValues(t, "b", row["name"])
+
+	out = service.Execute(ctx, &Input{Connector: "testConn", Query: "DELETE FROM items WHERE name = ?", Parameters: []interface{}{"a"}})
+	require.Equal(t, "ok", out.Status, out.Error)
+	assert.EqualValues(t, 1, out.RowsAffected)
+	assert.Nil(t, out.Data)
+}
@@ -0,0 +1,36 @@
+package exec
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/viant/mcp-sqlkit/db/dialect"
+)
+
+// Executor abstracts *sql.DB and *sql.Tx so that statements can run with or
+// without an enclosing transaction.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Transact runs fn within a database transaction. The transaction is committed
+// when fn succeeds and rolled back otherwise. Drivers without transaction
+// support (e.g. BigQuery) run fn directly against db.
+func Transact(ctx context.Context, db *sql.DB, driver string, fn func(ctx context.Context, executor Executor) error) error {
+	if !dialect.Of(driver).Transactional {
+		return fn(ctx, db)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(ctx, tx); err != nil {
+		if rErr := tx.Rollback(); rErr != nil && !errors.Is(rErr, sql.ErrTxDone) {
+			return errors.Join(err, rErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,164 @@
+package load
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/dialect"
+)
+
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"}
+
+// inferType returns the narrowest type family able to hold all sample values.
+func inferType(values []interface{}) dialect.Type {
+	var inferred *dialect.Type
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		candidate := valueType(value)
+		if inferred == nil {
+			inferred = &candidate
+			continue
+		}
+		if *inferred == candidate {
+			continue
+		}
+		if (*inferred == dialect.TypeInteger && candidate == dialect.TypeFloat) || (*inferred == dialect.TypeFloat && candidate == dialect.TypeInteger) {
+			widened := dialect.TypeFloat
+			inferred = &widened
+			continue
+		}
+		return dialect.TypeText
+	}
+	if inferred == nil {
+		return dialect.TypeText
+	}
+	return *inferred
+}
+
+func valueType(value interface{}) dialect.Type {
+	switch actual := value.(type) {
+	case bool:
+		return dialect.TypeBoolean
+	case float64:
+		if actual == float64(int64(actual)) {
+			return dialect.TypeInteger
+		}
+		return dialect.TypeFloat
+	case json.Number:
+		return numberType(actual)
+	case string:
+		return textType(actual)
+	}
+	return dialect.TypeText
+}
+
+// numberType returns integer for whole numbers within int64, float for fractions and exponents,
+// and text for integers too large to load without losing digits.
+func numberType(number json.Number) dialect.Type {
+	if _, err := number.Int64(); err == nil {
+		return dialect.TypeInteger
+	}
+	if !strings.ContainsAny(number.String(), ".eE") {
+		return dialect.TypeText
+	}
+	return dialect.TypeFloat
+}
+
+func textType(text string) dialect.Type {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return dialect.TypeText
+	}
+	// keep identifiers like zip codes with leading zeros as text
+	if len(text) > 1 && text[0] == '0' && text[1] != '.' {
+		return dialect.TypeText
+	}
+	if _, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return dialect.TypeInteger
+	}
+	if _, err := strconv.ParseFloat(text, 64); err == nil {
+		return dialect.TypeFloat
+	}
+	switch strings.ToLower(text) {
+	case "true", "false":
+		return dialect.TypeBoolean
+	}
+	if _, ok := parseTime(text); ok {
+		return dialect.TypeTimestamp
+	}
+	return dialect.TypeText
+}
+
+func parseTime(text string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if ts, err := time.Parse(layout, text); err == nil {
+			return ts, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// convert coerces a source value to Go type matching the column type family;
+// values that cannot be converted are passed unchanged.
+func convert(value interface{}, columnType dialect.Type) interface{} {
+	switch actual := value.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}, []interface{}:
+		data, _ := json.Marshal(actual)
+		return string(data)
+	case float64:
+		if columnType == dialect.TypeInteger {
+			return int64(actual)
+		}
+		return actual
+	case json.Number:
+		switch columnType {
+		case dialect.TypeInteger:
+			if v, err := actual.Int64(); err == nil {
+				return v
+			}
+		case dialect.TypeFloat:
+			if v, err := actual.Float64(); err == nil {
+				return v
+			}
+		}
+		return actual.String()
+	case string:
+		text := strings.TrimSpace(actual)
+		switch columnType {
+		case dialect.TypeInteger:
+			if v, err := strconv.ParseInt(text, 10, 64); err == nil {
+				return v
+			}
+		case dialect.TypeFloat:
+			if v, err := strconv.ParseFloat(text, 64); err == nil {
+				return v
+			}
+		case dialect.TypeBoolean:
+			if v, err := strconv.ParseBool(text); err == nil {
+				return v
+			}
+		case dialect.TypeTimestamp:
+			if v, ok := parseTime(text); ok {
+				return v
+			}
+		}
+		return actual
+	}
+	return value
+}
+
+func sortedKeys(record map[string]interface{}) []string {
+	keys := make([]string, 0, len(record))
+	for key := range record {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
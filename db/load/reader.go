@@ -0,0 +1,145 @@
+package load
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+const (
+	// FormatCSV represents comma separated values with a header row.
+	FormatCSV = "csv"
+	// FormatNDJSON represents newline delimited JSON objects.
+	FormatNDJSON = "ndjson"
+)
+
+// recordReader returns consecutive source records; io.EOF signals the end.
+type recordReader interface {
+	Next() (map[string]interface{}, error)
+	// Fields returns field names known so far in source order.
+	Fields() []string
+}
+
+// detectFormat infers format from the URL extension.
+func detectFormat(URL string) (string, error) {
+	ext := strings.ToLower(path.Ext(strings.TrimSuffix(URL, ".gz")))
+	switch ext {
+	case ".csv", ".tsv":
+		return FormatCSV, nil
+	case ".json", ".jsonl", ".ndjson":
+		return FormatNDJSON, nil
+	}
+	return "", fmt.Errorf("unable to detect format for %v, specify csv or ndjson", URL)
+}
+
+// defaultDelimiter returns the CSV delimiter implied by the URL extension: tab for .tsv, comma otherwise.
+func defaultDelimiter(URL string) string {
+	if strings.ToLower(path.Ext(strings.TrimSuffix(URL, ".gz"))) == ".tsv" {
+		return "\t"
+	}
+	return ","
+}
+
+func newRecordReader(format string, delimiter string, reader io.Reader) (recordReader, error) {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		return newCSVReader(reader, delimiter)
+	case FormatNDJSON, "json", "jsonl":
+		return &ndjsonReader{scanner: newScanner(reader), seen: map[string]bool{}}, nil
+	}
+	return nil, fmt.Errorf("unsupported format: %v", format)
+}
+
+type csvReader struct {
+	reader *csv.Reader
+	header []string
+}
+
+func newCSVReader(reader io.Reader, delimiter string) (*csvReader, error) {
+	ret := &csvReader{reader: csv.NewReader(reader)}
+	ret.reader.FieldsPerRecord = -1
+	ret.reader.ReuseRecord = true
+	if delimiter != "" {
+		ret.reader.Comma = []rune(delimiter)[0]
+	}
+	header, err := ret.reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("csv header was empty")
+		}
+		return nil, err
+	}
+	ret.header = make([]string, len(header))
+	for i, name := range header {
+		ret.header[i] = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+	}
+	return ret, nil
+}
+
+func (r *csvReader) Fields() []string {
+	return r.header
+}
+
+func (r *csvReader) Next() (map[string]interface{}, error) {
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]interface{}, len(r.header))
+	for i, name := range r.header {
+		if i >= len(record) || record[i] == "" {
+			result[name] = nil
+			continue
+		}
+		result[name] = strings.Clone(record[i])
+	}
+	return result, nil
+}
+
+type ndjsonReader struct {
+	scanner *bufio.Scanner
+	fields  []string
+	seen    map[string]bool
+}
+
+func newScanner(reader io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	return scanner
+}
+
+func (r *ndjsonReader) Fields() []string {
+	return r.fields
+}
+
+func (r *ndjsonReader) Next() (map[string]interface{}, error) {
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		record := map[string]interface{}{}
+		// numbers are kept as json.Number so that integers above 2^53 load exactly
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.UseNumber()
+		if err := decoder.Decode(&record); err != nil {
+			return nil, fmt.Errorf("invalid ndjson record: %w", err)
+		}
+		for _, name := range sortedKeys(record) {
+			if !r.seen[name] {
+				r.seen[name] = true
+				r.fields = append(r.fields, name)
+			}
+		}
+		return record, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
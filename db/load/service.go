@@ -0,0 +1,343 @@
+package load
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/viant/afs"
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/mcp-sqlkit/db/exec"
+)
+
+const (
+	defaultSampleSize = 100
+	defaultBatchSize  = 100
+)
+
+// Input defines parameters for loading a CSV/NDJSON file into a table.
+type Input struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// URL of the source file (any afs scheme, e.g. file://, mem://, gs://).
+	URL string `json:"url"`
+
+	// Format of the source file: csv or ndjson (detected from extension when empty).
+	Format string `json:"format,omitempty" choice:"csv" choice:"ndjson"`
+
+	// Delimiter for CSV files (default tab for .tsv, comma otherwise).
+	Delimiter string `json:"delimiter,omitempty"`
+
+	// Table receiving the data (optionally schema qualified).
+	Table string `json:"table"`
+
+	// Mapping maps source field names to target column names; when provided only mapped fields are loaded.
+	Mapping map[string]string `json:"mapping,omitempty"`
+
+	// CreateTable creates the target table with column types inferred from the sample.
+	CreateTable bool `json:"createTable,omitempty"`
+
+	// SampleSize controls number of records used for type inference (default 100).
+	SampleSize int `json:"sampleSize,omitempty"`
+
+	// BatchSize controls number of rows per INSERT statement (default 100).
+	BatchSize int `json:"batchSize,omitempty"`
+}
+
+// Column describes a loaded column.
+type Column struct {
+	Source string `json:"source"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+}
+
+// Output follows the same envelope format used by other DB tools.
+type Output struct {
+	RowsLoaded int64    `json:"rowsLoaded"`
+	Columns    []Column `json:"columns,omitempty"`
+	Created    bool     `json:"created,omitempty"`
+	Status     string   `json:"status"`
+	Error      string   `json:"error,omitempty"`
+	Connector  string   `json:"connector,omitempty"`
+}
+
+// Service loads files into connector databases.
+type Service struct {
+	connectors *connector.Service
+	fs         afs.Service
+}
+
+// New creates a load service instance.
+func New(connectors *connector.Service) *Service {
+	return &Service{connectors: connectors, fs: afs.New()}
+}
+
+// Load reads the source file and bulk inserts its records in a transaction.
+func (s *Service) Load(ctx context.Context, input *Input) *Output {
+	output := &Output{Status: "ok"}
+	if input == nil {
+		input = &Input{}
+	}
+	if err := s.load(ctx, input, output); err != nil {
+		output.Status = "error"
+		output.Error = err.Error()
+	}
+	output.Connector = input.Connector
+	return output
+}
+
+func (s *Service) load(ctx context.Context, input *Input, output *Output) error {
+	if input.URL == "" {
+		return fmt.Errorf("url was empty")
+	}
+	if input.Table == "" {
+		return fmt.Errorf("table was empty")
+	}
+	format := input.Format
+	if format == "" {
+		var err error
+		if format, err = detectFormat(input.URL); err != nil {
+			return err
+		}
+	}
+	con, err := s.connectors.Connection(ctx, input.Connector)
+	if err != nil {
+		return err
+	}
+	db, err := con.Db(ctx)
+	if err != nil {
+		return err
+	}
+
+	source, err := s.fs.OpenURL(ctx, input.URL)
+	if err != nil {
+		return fmt.Errorf("failed to open %v: %w", input.URL, err)
+	}
+	defer source.Close()
+	var stream io.Reader = source
+	if strings.HasSuffix(strings.ToLower(input.URL), ".gz") {
+		gz, err := gzip.NewReader(source)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		stream = gz
+	}
+	delimiter := input.Delimiter
+	if delimiter == "" {
+		delimiter = defaultDelimiter(input.URL)
+	}
+	reader, err := newRecordReader(format, delimiter, stream)
+	if err != nil {
+		return err
+	}
+
+	sampleSize := input.SampleSize
+	if sampleSize <= 0 {
+		sampleSize = defaultSampleSize
+	}
+	var sample []map[string]interface{}
+	for len(sample) < sampleSize {
+		record, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		sample = append(sample, record)
+	}
+
+	if missing := unmappedFields(reader.Fields(), input.Mapping); len(missing) > 0 {
+		return fmt.Errorf("mapping fields %v were not found in the first %d records of %v", strings.Join(missing, ", "), len(sample), input.URL)
+	}
+	columns, types := inferColumns(reader.Fields(), input.Mapping, sample)
+	if len(columns) == 0 {
+		return fmt.Errorf("no columns to load from %v", input.URL)
+	}
+	aDialect := dialect.Of(con.Driver)
+	for i := range columns {
+		columns[i].Type = aDialect.TypeName(types[i])
+	}
+	batchSize := input.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	if aDialect.Is("oracle") {
+		// Oracle has no multi-row VALUES clause.
+		batchSize = 1
+	}
+
+	if input.CreateTable {
+		// created outside the load transaction: MySQL and Oracle commit DDL implicitly,
+		// so the table is dropped explicitly when loading fails
+		if _, err := db.ExecContext(ctx, createTableDDL(aDialect, input.Table, columns)); err != nil {
+			return fmt.Errorf("failed to create table %v: %w", input.Table, err)
+		}
+		output.Created = true
+	}
+	known := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		known[column.Source] = true
+	}
+	err = exec.Transact(ctx, db, con.Driver, func(ctx context.Context, executor exec.Executor) error {
+		var batch [][]interface{}
+		flush := func() error {
+			if len(batch) == 0 {
+				return nil
+			}
+			SQL, args := insertStatement(aDialect, input.Table, columns, batch)
+			result, err := executor.ExecContext(ctx, SQL, args...)
+			if err != nil {
+				return err
+			}
+			if affected, err := result.RowsAffected(); err == nil && affected > 0 {
+				output.RowsLoaded += affected
+			} else {
+				output.RowsLoaded += int64(len(batch))
+			}
+			batch = batch[:0]
+			return nil
+		}
+		add := func(record map[string]interface{}) error {
+			if field := unknownField(record, known, input.Mapping); field != "" {
+				return fmt.Errorf("field %v first appears after the %d sampled records; increase sampleSize or exclude it with mapping", field, len(sample))
+			}
+			row := make([]interface{}, len(columns))
+			for i, column := range columns {
+				row[i] = convert(record[column.Source], types[i])
+			}
+			batch = append(batch, row)
+			if len(batch) >= batchSize {
+				return flush()
+			}
+			return nil
+		}
+		for _, record := range sample {
+			if err := add(record); err != nil {
+				return err
+			}
+		}
+		for {
+			record, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if err = add(record); err != nil {
+				return err
+			}
+		}
+		return flush()
+	})
+	if err != nil {
+		if output.Created {
+			if _, dropErr := db.ExecContext(ctx, "DROP TABLE "+aDialect.QuoteIdentifier(input.Table)); dropErr != nil {
+				err = fmt.Errorf("%w (failed to drop created table %v: %v)", err, input.Table, dropErr)
+			}
+			output.Created = false
+		}
+		return err
+	}
+	if output.Created {
+		s.connectors.SchemaChanged(ctx, con.Name)
+	}
+	output.Columns = columns
+	return nil
+}
+
+// inferColumns resolves target columns and their type families from source fields, mapping and sample records.
+func inferColumns(fields []string, mapping map[string]string, sample []map[string]interface{}) ([]Column, []dialect.Type) {
+	var columns []Column
+	var types []dialect.Type
+	for _, field := range fields {
+		name := field
+		if len(mapping) > 0 {
+			target, ok := mapping[field]
+			if !ok {
+				continue
+			}
+			name = target
+		}
+		values := make([]interface{}, 0, len(sample))
+		for _, record := range sample {
+			values = append(values, record[field])
+		}
+		columns = append(columns, Column{Source: field, Name: name})
+		types = append(types, inferType(values))
+	}
+	return columns, types
+}
+
+// unmappedFields returns sorted mapping source fields missing from the source fields.
+func unmappedFields(fields []string, mapping map[string]string) []string {
+	known := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		known[field] = true
+	}
+	var result []string
+	for field := range mapping {
+		if !known[field] {
+			result = append(result, field)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// unknownField returns a non-null record field that is loaded (mapped, or any field without mapping)
+// but was not seen in the sample, so no column exists for it.
+func unknownField(record map[string]interface{}, known map[string]bool, mapping map[string]string) string {
+	for field, value := range record {
+		if value == nil || known[field] {
+			continue
+		}
+		if len(mapping) > 0 {
+			if _, ok := mapping[field]; !ok {
+				continue
+			}
+		}
+		return field
+	}
+	return ""
+}
+
+func createTableDDL(d *dialect.Dialect, table string, columns []Column) string {
+	definitions := make([]string, len(columns))
+	for i, column := range columns {
+		definitions[i] = d.QuoteIdentifier(column.Name) + " " + column.Type
+	}
+	return "CREATE TABLE " + d.QuoteIdentifier(table) + " (" + strings.Join(definitions, ", ") + ")"
+}
+
+func insertStatement(d *dialect.Dialect, table string, columns []Column, rows [][]interface{}) (string, []interface{}) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = d.QuoteIdentifier(column.Name)
+	}
+	var sb strings.Builder
+	args := make([]interface{}, 0, len(rows)*len(columns))
+	sb.WriteString("INSERT INTO " + d.QuoteIdentifier(table) + " (" + strings.Join(names, ", ") + ") VALUES ")
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("(")
+		for j, value := range row {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			args = append(args, value)
+			sb.WriteString(d.Placeholder(len(args)))
+		}
+		sb.WriteString(")")
+	}
+	return sb.String(), args
+}
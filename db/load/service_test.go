@@ -0,0 +1,166 @@
+package load
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/viant/afs"
+	_ "github.com/viant/afs/mem"
+	_ "modernc.org/sqlite" // register SQLite driver
+
+	"github.com/viant/mcp-sqlkit/auth"
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/mcp-sqlkit/policy"
+	"github.com/viant/scy"
+)
+
+func TestInferType(t *testing.T) {
+	type testCase struct {
+		name   string
+		values []interface{}
+		expect dialect.Type
+	}
+
+	testCases := []testCase{
+		{name: "integer", values: []interface{}{"1", "20", nil}, expect: dialect.TypeInteger},
+		{name: "float", values: []interface{}{"1", "2.5"}, expect: dialect.TypeFloat},
+		{name: "json_number", values: []interface{}{float64(3), 4.25}, expect: dialect.TypeFloat},
+		{name: "json_big_integer", values: []interface{}{json.Number("9007199254740993"), json.Number("1")}, expect: dialect.TypeInteger},
+		{name: "json_fraction", values: []interface{}{json.Number("1"), json.Number("1.5e2")}, expect: dialect.TypeFloat},
+		{name: "json_overflow", values: []interface{}{json.Number("99999999999999999999")}, expect: dialect.TypeText},
+		{name: "boolean", values: []interface{}{"true", "FALSE"}, expect: dialect.TypeBoolean},
+		{name: "timestamp", values: []interface{}{"2024-01-02", "2024-01-02 10:11:12"}, expect: dialect.TypeTimestamp},
+		{name: "leading_zero", values: []interface{}{"02134"}, expect: dialect.TypeText},
+		{name: "mixed", values: []interface{}{"1", "abc"}, expect: dialect.TypeText},
+		{name: "empty", values: []interface{}{nil}, expect: dialect.TypeText},
+	}
+
+	for _, tc := range testCases {
+		assert.EqualValues(t, tc.expect, inferType(tc.values), tc.name)
+	}
+}
+
+func TestService_Load(t *testing.T) {
+	type testCase struct {
+		name        string
+		URL         string
+		content     string
+		table       string
+		mapping     map[string]string
+		sampleSize  int
+		expectRows  int64
+		expectTypes []string
+		expectError string
+	}
+
+	testCases := []testCase{
+		{
+			name:        "csv",
+			URL:         "mem://localhost/load/orders.csv",
+			content:     "id,amount,note\n1,10.5,first\n2,7,\n3,1.25,third\n",
+			table:       "orders",
+			expectRows:  3,
+			expectTypes: []string{"INTEGER", "REAL", "TEXT"},
+		},
+		{
+			name:        "ndjson_mapping",
+			URL:         "mem://localhost/load/events.ndjson",
+			content:     `{"id":1,"kind":"click","ok":true}` + "\n" + `{"id":2,"kind":"view","ok":false}` + "\n",
+			table:       "events",
+			mapping:     map[string]string{"id": "event_id", "kind": "event_kind"},
+			expectRows:  2,
+			expectTypes: []string{"INTEGER", "TEXT"},
+		},
+		{
+			name:        "tsv",
+			URL:         "mem://localhost/load/items.tsv",
+			content:     "id\tname\n1\tpen, blue\n2\tink\n",
+			table:       "items",
+			expectRows:  2,
+			expectTypes: []string{"INTEGER", "TEXT"},
+		},
+		{
+			name:        "ndjson_late_field",
+			URL:         "mem://localhost/load/late.ndjson",
+			content:     `{"id":1}` + "\n" + `{"id":2,"note":"late"}` + "\n",
+			table:       "late",
+			sampleSize:  1,
+			expectError: "field note first appears after the 1 sampled records",
+		},
+		{
+			name:        "ndjson_big_integer",
+			URL:         "mem://localhost/load/ids.ndjson",
+			content:     `{"id":9007199254740993,"ratio":0.5}` + "\n" + `{"id":2,"ratio":1}` + "\n",
+			table:       "ids",
+			expectRows:  2,
+			expectTypes: []string{"INTEGER", "REAL"},
+		},
+		{
+			name:        "ndjson_missing_mapping_field",
+			URL:         "mem://localhost/load/missing_mapping.ndjson",
+			content:     `{"id":1}` + "\n",
+			table:       "missing_mapping",
+			mapping:     map[string]string{"id": "id", "kind": "kind"},
+			expectError: "mapping fields kind were not found",
+		},
+		{
+			name:        "ndjson_late_unmapped_field",
+			URL:         "mem://localhost/load/late_mapped.ndjson",
+			content:     `{"id":1}` + "\n" + `{"id":2,"note":"late"}` + "\n",
+			table:       "late_mapped",
+			mapping:     map[string]string{"id": "id"},
+			sampleSize:  1,
+			expectRows:  2,
+			expectTypes: []string{"INTEGER"},
+		},
+	}
+
+	cfg := &connector.Config{}
+	mgr := connector.NewManager(cfg, auth.New(&policy.Policy{}), scy.New())
+	connSvc := connector.NewService(mgr, nil)
+	service := New(connSvc)
+
+	ctx := context.Background()
+	conn := &connector.Connector{Name: "testConn", Driver: "sqlite", DSN: "file:loaddb?mode=memory&cache=shared"}
+	pend, err := connSvc.GeneratePendingSecret(ctx, conn)
+	require.NoError(t, err)
+	pend.NS.Connectors.Put(conn.Name, conn)
+	db, err := conn.Db(ctx)
+	require.NoError(t, err)
+
+	fs := afs.New()
+	for _, tc := range testCases {
+		require.NoError(t, fs.Upload(ctx, tc.URL, 0644, strings.NewReader(tc.content)), tc.name)
+		out := service.Load(ctx, &Input{Connector: "testConn", URL: tc.URL, Table: tc.table, Mapping: tc.mapping, SampleSize: tc.sampleSize, CreateTable: true})
+		if tc.expectError != "" {
+			assert.Equal(t, "error", out.Status, tc.name)
+			assert.Contains(t, out.Error, tc.expectError, tc.name)
+			assert.False(t, out.Created, tc.name)
+			var count int
+			require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE name = ?", tc.table).Scan(&count), tc.name)
+			assert.Zero(t, count, "created table is dropped on failure: "+tc.name)
+			continue
+		}
+		require.Equal(t, "ok", out.Status, out.Error)
+		assert.True(t, out.Created, tc.name)
+		assert.EqualValues(t, tc.expectRows, out.RowsLoaded, tc.name)
+		var types []string
+		for _, column := range out.Columns {
+			types = append(types, column.Type)
+		}
+		assert.EqualValues(t, tc.expectTypes, types, tc.name)
+
+		var count int64
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+tc.table).Scan(&count), tc.name)
+		assert.EqualValues(t, tc.expectRows, count, tc.name)
+	}
+
+	var id int64
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT MAX(id) FROM ids").Scan(&id))
+	assert.EqualValues(t, int64(9007199254740993), id)
+}
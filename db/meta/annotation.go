@@ -0,0 +1,446 @@
+package meta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
+	"github.com/viant/afs/url"
+	"github.com/viant/sqlx/metadata/sink"
+)
+
+// DefaultAnnotationsURL returns the default base location of annotation files:
+// mcp-sqlkit/annotations under the user configuration directory, so that
+// annotations survive restarts; in-memory storage is used only when the
+// directory cannot be determined.
+func DefaultAnnotationsURL() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		log.Printf("mcp-sqlkit annotations are kept in memory: %v", err)
+		return "mem://localhost/mcp-sqlkit/annotations/"
+	}
+	location := filepath.ToSlash(filepath.Join(dir, "mcp-sqlkit", "annotations"))
+	if !strings.HasPrefix(location, "/") {
+		location = "/" + location
+	}
+	return "file://" + location + "/"
+}
+
+// Annotation describes business meaning of a table or column.
+type Annotation struct {
+	Description string   `json:"description,omitempty"`
+	Unit        string   `json:"unit,omitempty"`
+	Values      []string `json:"values,omitempty"`
+	PII         bool     `json:"pii,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Examples    []string `json:"examples,omitempty"`
+}
+
+// IsEmpty reports whether no annotation field is set.
+func (a *Annotation) IsEmpty() bool {
+	return a.Description == "" && a.Unit == "" && !a.PII && len(a.Values) == 0 && len(a.Tags) == 0 && len(a.Examples) == 0
+}
+
+// TableAnnotation annotates a table and its columns (keyed by column name).
+type TableAnnotation struct {
+	Annotation
+	Columns map[string]*Annotation `json:"columns,omitempty"`
+}
+
+// Annotations is the content of a connector annotation file; tables are keyed
+// by table name or schema.table.
+type Annotations struct {
+	Tables map[string]*TableAnnotation `json:"tables,omitempty"`
+}
+
+// Table returns the annotation of a table matching schema.table or table, ignoring case.
+func (a *Annotations) Table(schema, table string) *TableAnnotation {
+	if a == nil {
+		return nil
+	}
+	var match *TableAnnotation
+	for key, candidate := range a.Tables {
+		if schema != "" && strings.EqualFold(key, schema+"."+table) {
+			return candidate
+		}
+		if strings.EqualFold(key, table) {
+			match = candidate
+		}
+	}
+	return match
+}
+
+// Column returns the annotation of a column, ignoring case.
+func (t *TableAnnotation) Column(name string) *Annotation {
+	if t == nil {
+		return nil
+	}
+	if annotation, ok := t.Columns[name]; ok {
+		return annotation
+	}
+	for key, annotation := range t.Columns {
+		if strings.EqualFold(key, name) {
+			return annotation
+		}
+	}
+	return nil
+}
+
+// AnnotationStore reads and writes per-connector annotation files at
+// <baseURL>/<namespace>/<connector>.json on any afs location. Parsed files are
+// cached while their modification time and size stay the same.
+type AnnotationStore struct {
+	baseURL  string
+	fs       afs.Service
+	mux      sync.Mutex
+	cacheMux sync.RWMutex
+	cache    map[string]*annotationEntry
+}
+
+// NewAnnotationStore creates an annotation store; an empty baseURL uses DefaultAnnotationsURL.
+func NewAnnotationStore(baseURL string) *AnnotationStore {
+	if baseURL == "" {
+		baseURL = DefaultAnnotationsURL()
+	}
+	return &AnnotationStore{baseURL: baseURL, fs: afs.New(), cache: map[string]*annotationEntry{}}
+}
+
+// WithAnnotations merges annotations from the store into metadata output.
+func WithAnnotations(store *AnnotationStore) Option {
+	return func(s *Service) {
+		s.annotations = store
+	}
+}
+
+// URL returns the annotation file location of a connector.
+func (a *AnnotationStore) URL(namespace, connector string) string {
+	return url.Join(a.baseURL, neturl.PathEscape(namespace), neturl.PathEscape(connector)+".json")
+}
+
+// Load returns cached connector annotations, reading the file again when its modification
+// time or size changed, e.g. after a manual edit; a missing file yields empty annotations.
+// The result must not be modified.
+func (a *AnnotationStore) Load(ctx context.Context, namespace, connector string) (*Annotations, error) {
+	URL := a.URL(namespace, connector)
+	version := a.version(ctx, URL)
+	a.cacheMux.RLock()
+	entry, ok := a.cache[URL]
+	a.cacheMux.RUnlock()
+	if ok && entry.version == version {
+		return entry.annotations, nil
+	}
+	// reading under the update lock keeps a concurrent Update from being overwritten by a stale copy
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	annotations, err := a.read(ctx, URL)
+	if err != nil {
+		return nil, err
+	}
+	a.cacheMux.Lock()
+	a.cache[URL] = &annotationEntry{annotations: annotations, version: version}
+	a.cacheMux.Unlock()
+	return annotations, nil
+}
+
+// annotationEntry is a parsed annotation file with the version it was read at.
+type annotationEntry struct {
+	annotations *Annotations
+	version     fileVersion
+}
+
+// fileVersion identifies file content by modification time and size; zero for a missing file.
+type fileVersion struct {
+	modified int64
+	size     int64
+}
+
+func (a *AnnotationStore) version(ctx context.Context, URL string) fileVersion {
+	object, err := a.fs.Object(ctx, URL)
+	if err != nil {
+		return fileVersion{}
+	}
+	return fileVersion{modified: object.ModTime().UnixNano(), size: object.Size()}
+}
+
+// invalidate drops cached connector annotations.
+func (a *AnnotationStore) invalidate(namespace, connector string) {
+	a.cacheMux.Lock()
+	delete(a.cache, a.URL(namespace, connector))
+	a.cacheMux.Unlock()
+}
+
+// read parses an annotation file bypassing the cache.
+func (a *AnnotationStore) read(ctx context.Context, URL string) (*Annotations, error) {
+	if ok, _ := a.fs.Exists(ctx, URL); !ok {
+		return &Annotations{}, nil
+	}
+	data, err := a.fs.DownloadWithURL(ctx, URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read annotations %v: %w", URL, err)
+	}
+	ret := &Annotations{}
+	if err = json.Unmarshal(data, ret); err != nil {
+		return nil, fmt.Errorf("invalid annotations %v: %w", URL, err)
+	}
+	return ret, nil
+}
+
+// Update applies fn to connector annotations read from the file, writes them back
+// and invalidates the cached copy.
+func (a *AnnotationStore) Update(ctx context.Context, namespace, connector string, fn func(annotations *Annotations) error) (*Annotations, error) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	URL := a.URL(namespace, connector)
+	annotations, err := a.read(ctx, URL)
+	if err != nil {
+		return nil, err
+	}
+	if err = fn(annotations); err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(annotations, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	defer a.invalidate(namespace, connector)
+	if err = a.fs.Upload(ctx, URL, file.DefaultFileOsMode, strings.NewReader(string(data))); err != nil {
+		return nil, fmt.Errorf("failed to write annotations %v: %w", URL, err)
+	}
+	return annotations, nil
+}
+
+// AnnotateInput defines an annotation change of a table or column.
+type AnnotateInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Schema name (optional – annotations without schema apply to any schema).
+	Schema string `json:"schema,omitempty"`
+
+	// Table to annotate.
+	Table string `json:"table"`
+
+	// Column to annotate (optional – the table is annotated when empty).
+	Column string `json:"column,omitempty"`
+
+	// Description of business meaning.
+	Description string `json:"description,omitempty"`
+
+	// Unit of measure, e.g. USD, ms, bytes.
+	Unit string `json:"unit,omitempty"`
+
+	// Values lists allowed values or codes with meaning, e.g. "A=active".
+	Values []string `json:"values,omitempty"`
+
+	// PII marks personal data; omit to keep the current flag.
+	PII *bool `json:"pii,omitempty"`
+
+	// Tags classify the table or column.
+	Tags []string `json:"tags,omitempty"`
+
+	// Examples are example queries.
+	Examples []string `json:"examples,omitempty"`
+
+	// Remove deletes the table or column annotation instead of updating it.
+	Remove bool `json:"remove,omitempty"`
+}
+
+// AnnotateOutput returns the table annotation after the change.
+type AnnotateOutput struct {
+	Data      *TableAnnotation `json:"data,omitempty"`
+	Location  string           `json:"location,omitempty"`
+	Status    string           `json:"status"`
+	Error     string           `json:"error,omitempty"`
+	Connector string           `json:"connector,omitempty"`
+}
+
+// Annotate adds, updates or removes a table or column annotation.
+func (s *Service) Annotate(ctx context.Context, input *AnnotateInput) *AnnotateOutput {
+	out := &AnnotateOutput{Status: "ok"}
+	if input == nil {
+		input = &AnnotateInput{}
+	}
+	if err := s.annotate(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) annotate(ctx context.Context, input *AnnotateInput, out *AnnotateOutput) error {
+	if input.Table == "" {
+		return fmt.Errorf("table was empty")
+	}
+	if s.annotations == nil {
+		return fmt.Errorf("annotations are not configured")
+	}
+	requestedConnector := input.Connector
+	conn, db, err := s.connection(ctx, requestedConnector)
+	if err != nil {
+		return err
+	}
+	namespace, err := s.connectors.Namespace(ctx)
+	if err != nil {
+		return err
+	}
+	catalog, schema := "", input.Schema
+	s.applyDefaults(ctx, conn, db, &catalog, &schema)
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbAnnotate start connector=%q table=%q column=%q remove=%v", requestedConnector, input.Table, input.Column, input.Remove)
+	if !input.Remove {
+		columns, _, err := s.columns(ctx, conn, db, catalog, schema, input.Table, false)
+		if err != nil {
+			return err
+		}
+		if len(columns) == 0 {
+			return fmt.Errorf("table %v not found", input.Table)
+		}
+		if input.Column != "" && !slices.ContainsFunc(columns, func(column sink.Column) bool { return strings.EqualFold(column.Name, input.Column) }) {
+			return fmt.Errorf("column %v not found in %v", input.Column, input.Table)
+		}
+	}
+	key := input.Table
+	if input.Schema != "" {
+		key = input.Schema + "." + input.Table
+	}
+	annotations, err := s.annotations.Update(ctx, namespace, conn.Name, func(annotations *Annotations) error {
+		if annotations.Tables == nil {
+			annotations.Tables = map[string]*TableAnnotation{}
+		}
+		key = matchKey(annotations.Tables, key)
+		table := annotations.Tables[key]
+		column := ""
+		if table != nil {
+			column = matchKey(table.Columns, input.Column)
+		}
+		if input.Remove {
+			switch {
+			case table == nil:
+			case input.Column == "":
+				delete(annotations.Tables, key)
+			default:
+				delete(table.Columns, column)
+			}
+			return nil
+		}
+		if table == nil {
+			table = &TableAnnotation{}
+			annotations.Tables[key] = table
+		}
+		target := &table.Annotation
+		if input.Column != "" {
+			if table.Columns == nil {
+				table.Columns = map[string]*Annotation{}
+			}
+			if column == "" {
+				column = input.Column
+			}
+			if table.Columns[column] == nil {
+				table.Columns[column] = &Annotation{}
+			}
+			target = table.Columns[column]
+		}
+		input.apply(target)
+		return nil
+	})
+	if err != nil {
+		log.Printf("mcp-sqlkit dbAnnotate error connector=%q table=%q elapsed=%s err=%v", requestedConnector, input.Table, time.Since(started), err)
+		return err
+	}
+	log.Printf("mcp-sqlkit dbAnnotate done connector=%q table=%q column=%q elapsed=%s", requestedConnector, input.Table, input.Column, time.Since(started))
+	out.Connector = requestedConnector
+	out.Data = annotations.Tables[key]
+	out.Location = s.annotations.URL(namespace, conn.Name)
+	return nil
+}
+
+// matchKey returns the existing map key equal to key ignoring case, or key itself.
+func matchKey[T any](values map[string]T, key string) string {
+	if _, ok := values[key]; ok {
+		return key
+	}
+	for candidate := range values {
+		if strings.EqualFold(candidate, key) {
+			return candidate
+		}
+	}
+	return key
+}
+
+// apply sets provided fields on the annotation and keeps the others.
+func (i *AnnotateInput) apply(annotation *Annotation) {
+	if i.Description != "" {
+		annotation.Description = i.Description
+	}
+	if i.Unit != "" {
+		annotation.Unit = i.Unit
+	}
+	if len(i.Values) > 0 {
+		annotation.Values = i.Values
+	}
+	if i.PII != nil {
+		annotation.PII = *i.PII
+	}
+	if len(i.Tags) > 0 {
+		annotation.Tags = i.Tags
+	}
+	if len(i.Examples) > 0 {
+		annotation.Examples = i.Examples
+	}
+}
+
+// tableAnnotation returns the annotation of a table, see connectorAnnotations.
+func (s *Service) tableAnnotation(ctx context.Context, conn string, schema, table string) *TableAnnotation {
+	return s.connectorAnnotations(ctx, conn).Table(schema, table)
+}
+
+// connectorAnnotations loads connector annotations; failures are logged and ignored
+// so that metadata tools keep working with a broken annotation file.
+func (s *Service) connectorAnnotations(ctx context.Context, conn string) *Annotations {
+	if s.annotations == nil {
+		return nil
+	}
+	namespace, err := s.connectors.Namespace(ctx)
+	if err != nil {
+		return nil
+	}
+	annotations, err := s.annotations.Load(ctx, namespace, conn)
+	if err != nil {
+		log.Printf("mcp-sqlkit annotations error connector=%q err=%v", conn, err)
+		return nil
+	}
+	return annotations
+}
+
+// annotated returns a copy of the description with table and column annotations merged;
+// cached descriptions are never modified.
+func annotated(description *TableDescription, annotation *TableAnnotation) *TableDescription {
+	if annotation == nil {
+		return description
+	}
+	ret := *description
+	if !annotation.Annotation.IsEmpty() {
+		tableAnnotation := annotation.Annotation
+		ret.Annotation = &tableAnnotation
+	}
+	ret.Columns = make([]*ColumnInfo, len(description.Columns))
+	for i, column := range description.Columns {
+		ret.Columns[i] = column
+		if columnAnnotation := annotation.Column(column.Name); columnAnnotation != nil {
+			annotatedColumn := *column
+			annotatedColumn.Annotation = columnAnnotation
+			ret.Columns[i] = &annotatedColumn
+		}
+	}
+	return &ret
+}
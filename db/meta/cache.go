@@ -0,0 +1,119 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+)
+
+// DefaultCacheTTL defines how long catalog metadata is reused by default.
+const DefaultCacheTTL = 5 * time.Minute
+
+// Cache keeps catalog metadata (tables, columns, keys) per namespace and
+// connector so that repeated tool calls do not hit slow catalog views.
+type Cache struct {
+	ttl     time.Duration
+	mux     sync.RWMutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// Option customises metadata service.
+type Option func(s *Service)
+
+// WithCache shares metadata cache with the service.
+func WithCache(cache *Cache) Option {
+	return func(s *Service) {
+		s.cache = cache
+	}
+}
+
+// NewCache creates a metadata cache; ttl <= 0 disables caching.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, entries: map[string]*cacheEntry{}}
+}
+
+// Invalidate removes all entries of a connector in a namespace.
+func (c *Cache) Invalidate(namespace, connector string) {
+	if c == nil {
+		return
+	}
+	prefix := cacheKey(namespace, connector)
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *Cache) get(key string) (interface{}, bool) {
+	c.mux.RLock()
+	entry, ok := c.entries[key]
+	c.mux.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		c.mux.Lock()
+		delete(c.entries, key)
+		c.mux.Unlock()
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *Cache) put(key string, value interface{}) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.entries[key] = &cacheEntry{value: value, expires: time.Now().Add(c.ttl)}
+}
+
+func (c *Cache) enabled() bool {
+	return c != nil && c.ttl > 0
+}
+
+// cacheKey builds namespace and connector scoped key; the trailing separator
+// keeps connector prefixes (e.g. dev, dev2) distinct on invalidation.
+func cacheKey(namespace, connector string, parts ...interface{}) string {
+	key := namespace + "|" + connector + "|"
+	for _, part := range parts {
+		key += fmt.Sprint(part) + "|"
+	}
+	return key
+}
+
+// cached returns connector metadata from the service cache or loads and
+// stores it; the boolean result reports a cache hit.
+func cached[T any](ctx context.Context, s *Service, conn *connector.Connector, refresh bool, load func() (T, error), parts ...interface{}) (T, bool, error) {
+	if !s.cache.enabled() {
+		value, err := load()
+		return value, false, err
+	}
+	namespace, err := s.connectors.Namespace(ctx)
+	if err != nil {
+		value, err := load()
+		return value, false, err
+	}
+	key := cacheKey(namespace, conn.Name, parts...)
+	if !refresh {
+		if value, ok := s.cache.get(key); ok {
+			return value.(T), true, nil
+		}
+	}
+	value, err := load()
+	if err != nil {
+		return value, false, err
+	}
+	s.cache.put(key, value)
+	return value, false, nil
+}
@@ -0,0 +1,94 @@
+package meta
+
+import "github.com/viant/mcp-sqlkit/db/dialect"
+
+// tableQueries holds dialect specific catalog queries used where sqlx key and
+// index metadata is missing or inaccurate (composite keys, column order).
+//
+// Key queries return name, column, position and, for foreign keys, referenced
+// schema, table, column, update and delete rules. Index queries return name,
+// unique, type, primary, position and column – one row per index column.
+type tableQueries struct {
+	primaryKey  string
+	foreignKeys string
+	indexes     string
+	args        func(schema, table string) []interface{}
+}
+
+func schemaTableArgs(schema, table string) []interface{} {
+	return []interface{}{schema, table}
+}
+
+func tableArgs(_ string, table string) []interface{} {
+	return []interface{}{table}
+}
+
+var mysqlQueries = &tableQueries{
+	primaryKey: `SELECT CONSTRAINT_NAME, COLUMN_NAME, ORDINAL_POSITION
+FROM information_schema.KEY_COLUMN_USAGE
+WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY'`,
+	foreignKeys: `SELECT k.CONSTRAINT_NAME, k.COLUMN_NAME, k.ORDINAL_POSITION, k.REFERENCED_TABLE_SCHEMA, k.REFERENCED_TABLE_NAME, k.REFERENCED_COLUMN_NAME, r.UPDATE_RULE, r.DELETE_RULE
+FROM information_schema.KEY_COLUMN_USAGE k
+JOIN information_schema.REFERENTIAL_CONSTRAINTS r ON r.CONSTRAINT_SCHEMA = k.CONSTRAINT_SCHEMA AND r.CONSTRAINT_NAME = k.CONSTRAINT_NAME
+WHERE k.TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND k.TABLE_NAME = ?`,
+	indexes: `SELECT INDEX_NAME, NON_UNIQUE = 0, INDEX_TYPE, INDEX_NAME = 'PRIMARY', SEQ_IN_INDEX, COLUMN_NAME
+FROM information_schema.STATISTICS
+WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND TABLE_NAME = ?`,
+	args: schemaTableArgs,
+}
+
+var postgresQueries = &tableQueries{
+	primaryKey: `SELECT c.conname, a.attname, k.ord
+FROM pg_constraint c
+JOIN pg_class t ON t.oid = c.conrelid
+JOIN pg_namespace n ON n.oid = t.relnamespace
+CROSS JOIN LATERAL unnest(c.conkey) WITH ORDINALITY AS k(attnum, ord)
+JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum
+WHERE c.contype = 'p' AND n.nspname = COALESCE(NULLIF($1, ''), current_schema()) AND t.relname = $2`,
+	foreignKeys: `SELECT c.conname, a.attname, k.ord, rn.nspname, rt.relname, ra.attname,
+CASE c.confupdtype WHEN 'c' THEN 'CASCADE' WHEN 'n' THEN 'SET NULL' WHEN 'd' THEN 'SET DEFAULT' WHEN 'r' THEN 'RESTRICT' ELSE 'NO ACTION' END,
+CASE c.confdeltype WHEN 'c' THEN 'CASCADE' WHEN 'n' THEN 'SET NULL' WHEN 'd' THEN 'SET DEFAULT' WHEN 'r' THEN 'RESTRICT' ELSE 'NO ACTION' END
+FROM pg_constraint c
+JOIN pg_class t ON t.oid = c.conrelid
+JOIN pg_namespace n ON n.oid = t.relnamespace
+JOIN pg_class rt ON rt.oid = c.confrelid
+JOIN pg_namespace rn ON rn.oid = rt.relnamespace
+CROSS JOIN LATERAL unnest(c.conkey, c.confkey) WITH ORDINALITY AS k(attnum, refnum, ord)
+JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum
+JOIN pg_attribute ra ON ra.attrelid = rt.oid AND ra.attnum = k.refnum
+WHERE c.contype = 'f' AND n.nspname = COALESCE(NULLIF($1, ''), current_schema()) AND t.relname = $2`,
+	indexes: `SELECT i.relname, x.indisunique, am.amname, x.indisprimary, k.ord, COALESCE(a.attname, pg_get_indexdef(x.indexrelid, k.ord::int, true))
+FROM pg_index x
+JOIN pg_class t ON t.oid = x.indrelid
+JOIN pg_namespace n ON n.oid = t.relnamespace
+JOIN pg_class i ON i.oid = x.indexrelid
+JOIN pg_am am ON am.oid = i.relam
+CROSS JOIN LATERAL unnest(x.indkey::int2[]) WITH ORDINALITY AS k(attnum, ord)
+LEFT JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum AND k.attnum > 0
+WHERE n.nspname = COALESCE(NULLIF($1, ''), current_schema()) AND t.relname = $2 AND k.ord <= x.indnkeyatts`,
+	args: schemaTableArgs,
+}
+
+var sqliteQueries = &tableQueries{
+	primaryKey: `SELECT 'PRIMARY', name, pk FROM pragma_table_info(?) WHERE pk > 0`,
+	// a reference without columns targets the primary key, reported with a NULL "to"
+	foreignKeys: "SELECT 'fk_' || f.id, f.`from`, f.seq, '', f.`table`, " +
+		"COALESCE(f.`to`, (SELECT p.name FROM pragma_table_info(f.`table`) AS p WHERE p.pk = f.seq + 1)), f.on_update, f.on_delete " +
+		"FROM pragma_foreign_key_list(?) AS f",
+	indexes: "SELECT l.name, l.`unique`, '', l.origin = 'pk', i.seqno + 1, COALESCE(i.name, '<expression>') " +
+		"FROM pragma_index_list(?) AS l, pragma_index_info(l.name) AS i",
+	args: tableArgs,
+}
+
+// catalogQueries returns dialect specific table queries for a driver.
+func catalogQueries(driver string) (*tableQueries, bool) {
+	switch dialect.Of(driver).Driver {
+	case "mysql":
+		return mysqlQueries, true
+	case "postgres":
+		return postgresQueries, true
+	case "sqlite":
+		return sqliteQueries, true
+	}
+	return nil, false
+}
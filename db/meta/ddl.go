@@ -0,0 +1,336 @@
+package meta
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+)
+
+const (
+	// SourceNative marks DDL returned by the database itself.
+	SourceNative = "native"
+	// SourceSynthesized marks DDL generated from column and key metadata.
+	SourceSynthesized = "synthesized"
+)
+
+// ShowCreateInput defines parameters for retrieving table or view DDL.
+type ShowCreateInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Catalog/database name (optional).
+	Catalog string `json:"catalog,omitempty"`
+
+	// Schema name (optional – defaults to the connector schema).
+	Schema string `json:"schema,omitempty"`
+
+	// Table or view name.
+	Table string `json:"table"`
+
+	// TargetDriver translates table DDL to another driver dialect (optional).
+	TargetDriver string `json:"targetDriver,omitempty" choice:"mysql" choice:"postgres" choice:"sqlite" choice:"oracle" choice:"bigquery"`
+
+	// Refresh bypasses the metadata cache and reloads it from the database catalog.
+	Refresh bool `json:"refresh,omitempty"`
+}
+
+// ShowCreateOutput wraps generated DDL.
+type ShowCreateOutput struct {
+	Data      *CreateStatement `json:"data,omitempty"`
+	Status    string           `json:"status"`
+	Error     string           `json:"error,omitempty"`
+	Connector string           `json:"connector,omitempty"`
+}
+
+// CreateStatement holds DDL of a table or view.
+type CreateStatement struct {
+	Table  string `json:"table"`
+	Type   string `json:"type"`
+	Driver string `json:"driver"`
+	Source string `json:"source"`
+	DDL    string `json:"ddl"`
+}
+
+// ShowCreate returns CREATE statement for a table or view, optionally translated to another dialect.
+func (s *Service) ShowCreate(ctx context.Context, input *ShowCreateInput) *ShowCreateOutput {
+	out := &ShowCreateOutput{Status: "ok"}
+	if input == nil {
+		input = &ShowCreateInput{}
+	}
+	if err := s.showCreate(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) showCreate(ctx context.Context, input *ShowCreateInput, out *ShowCreateOutput) error {
+	if input.Table == "" {
+		return fmt.Errorf("table was empty")
+	}
+	requestedConnector := input.Connector
+	conn, db, err := s.connection(ctx, requestedConnector)
+	if err != nil {
+		return err
+	}
+	s.applyDefaults(ctx, conn, db, &input.Catalog, &input.Schema)
+	source := dialect.Of(conn.Driver)
+	target := source
+	if input.TargetDriver != "" {
+		target = dialect.Of(input.TargetDriver)
+	}
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbShowCreate start connector=%q driver=%q schema=%q table=%q target=%q", requestedConnector, conn.Driver, input.Schema, input.Table, target.Driver)
+	statement, err := s.createStatement(ctx, conn, db, input, source, target)
+	if err != nil {
+		log.Printf("mcp-sqlkit dbShowCreate error connector=%q driver=%q table=%q elapsed=%s err=%v", requestedConnector, conn.Driver, input.Table, time.Since(started), err)
+		return err
+	}
+	log.Printf("mcp-sqlkit dbShowCreate done connector=%q driver=%q table=%q source=%q elapsed=%s", requestedConnector, conn.Driver, input.Table, statement.Source, time.Since(started))
+	out.Connector = requestedConnector
+	out.Data = statement
+	return nil
+}
+
+func (s *Service) createStatement(ctx context.Context, conn *connector.Connector, db *sql.DB, input *ShowCreateInput, source, target *dialect.Dialect) (*CreateStatement, error) {
+	ret := &CreateStatement{Table: input.Table, Type: TableTypeTable, Driver: target.Driver}
+	if view, err := viewDefinition(ctx, db, source, input.Schema, input.Table); err != nil {
+		return nil, err
+	} else if view != "" {
+		if target.Driver != source.Driver {
+			return nil, fmt.Errorf("translating view %v to %v is not supported", input.Table, target.Driver)
+		}
+		ret.Type, ret.Source, ret.DDL = TableTypeView, SourceNative, view
+		return ret, nil
+	}
+	if target.Driver == source.Driver {
+		DDL, err := nativeDDL(ctx, db, source, input.Schema, input.Table)
+		if err != nil {
+			return nil, err
+		}
+		if DDL != "" {
+			ret.Source, ret.DDL = SourceNative, DDL
+			return ret, nil
+		}
+	}
+	description, _, err := s.describe(ctx, conn, db, input.Catalog, input.Schema, input.Table, input.Refresh)
+	if err != nil {
+		return nil, err
+	}
+	qualify := target.Driver == source.Driver && input.Schema != "" && !source.Is("sqlite")
+	ret.Source, ret.DDL = SourceSynthesized, synthesizeDDL(description, target, target.Driver != source.Driver, qualify)
+	return ret, nil
+}
+
+// viewDefinition returns CREATE VIEW statement when name refers to a view.
+func viewDefinition(ctx context.Context, db *sql.DB, d *dialect.Dialect, schema, name string) (string, error) {
+	switch d.Driver {
+	case "mysql", "oracle":
+		// existence check only – DDL comes from native commands
+		SQL := "SELECT COUNT(*) FROM information_schema.VIEWS WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND TABLE_NAME = ?"
+		args := []interface{}{schema, name}
+		if d.Is("oracle") {
+			SQL = "SELECT COUNT(*) FROM ALL_VIEWS WHERE OWNER = NVL(:1, SYS_CONTEXT('USERENV','CURRENT_SCHEMA')) AND VIEW_NAME = :2"
+			args = []interface{}{nullable(schema), name}
+		}
+		var count int
+		if err := db.QueryRowContext(ctx, SQL, args...).Scan(&count); err != nil || count == 0 {
+			return "", err
+		}
+		return nativeDDL(ctx, db, d, schema, name)
+	}
+	var SQL string
+	var args []interface{}
+	switch d.Driver {
+	case "sqlite":
+		SQL = "SELECT sql FROM sqlite_master WHERE type = 'view' AND name = ?"
+		args = []interface{}{name}
+	case "postgres":
+		SQL = `SELECT CASE c.relkind WHEN 'm' THEN 'CREATE MATERIALIZED VIEW ' ELSE 'CREATE VIEW ' END
+|| quote_ident(n.nspname) || '.' || quote_ident(c.relname) || ' AS' || chr(10) || pg_get_viewdef(c.oid, true)
+FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('v', 'm') AND n.nspname = COALESCE(NULLIF($1, ''), current_schema()) AND c.relname = $2`
+		args = []interface{}{schema, name}
+	case "bigquery":
+		SQL = "SELECT CONCAT('CREATE VIEW `', table_schema, '.', table_name, '` AS ', view_definition) FROM " +
+			d.QuoteIdentifier(qualified(schema, "INFORMATION_SCHEMA.VIEWS")) + " WHERE table_name = ?"
+		args = []interface{}{name}
+	default:
+		return "", nil
+	}
+	var DDL sql.NullString
+	if err := db.QueryRowContext(ctx, SQL, args...).Scan(&DDL); err != nil && err != sql.ErrNoRows {
+		return "", err
+	}
+	return DDL.String, nil
+}
+
+// nativeDDL returns DDL using database commands; empty result means DDL must be synthesized.
+func nativeDDL(ctx context.Context, db *sql.DB, d *dialect.Dialect, schema, name string) (string, error) {
+	switch d.Driver {
+	case "mysql":
+		rows, err := db.QueryContext(ctx, "SHOW CREATE TABLE "+d.QuoteIdentifier(qualified(schema, name)))
+		if err != nil {
+			return "", err
+		}
+		defer rows.Close()
+		columns, err := rows.Columns()
+		if err != nil {
+			return "", err
+		}
+		if !rows.Next() {
+			return "", fmt.Errorf("table %v not found", name)
+		}
+		values := make([]sql.RawBytes, len(columns))
+		targets := make([]interface{}, len(columns))
+		for i := range values {
+			targets[i] = &values[i]
+		}
+		if err = rows.Scan(targets...); err != nil {
+			return "", err
+		}
+		// SHOW CREATE returns name, statement (and view charset/collation)
+		return string(values[1]), rows.Err()
+	case "sqlite":
+		var DDL sql.NullString
+		err := db.QueryRowContext(ctx, "SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", name).Scan(&DDL)
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("table %v not found", name)
+		}
+		return DDL.String, err
+	case "oracle":
+		var DDL string
+		err := db.QueryRowContext(ctx, `SELECT DBMS_METADATA.GET_DDL(O.OBJECT_TYPE, O.OBJECT_NAME, O.OWNER) FROM ALL_OBJECTS O
+WHERE O.OWNER = NVL(:1, SYS_CONTEXT('USERENV','CURRENT_SCHEMA')) AND O.OBJECT_NAME = :2 AND O.OBJECT_TYPE IN ('TABLE', 'VIEW')`, nullable(schema), name).Scan(&DDL)
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("table %v not found", name)
+		}
+		return strings.TrimSpace(DDL), err
+	}
+	return "", nil
+}
+
+// synthesizeDDL builds CREATE TABLE and CREATE INDEX statements from table metadata.
+func synthesizeDDL(description *TableDescription, d *dialect.Dialect, translate, qualify bool) string {
+	name := description.Table
+	if qualify {
+		name = qualified(description.Schema, name)
+	}
+	primaryKey := map[string]bool{}
+	for _, column := range description.PrimaryKey {
+		primaryKey[column] = true
+	}
+	var definitions []string
+	for _, column := range description.Columns {
+		// primary key columns are implicitly NOT NULL (SQLite reports them nullable)
+		definitions = append(definitions, columnDefinition(d, column, translate, !column.Nullable || primaryKey[column.Name]))
+	}
+	if len(description.PrimaryKey) > 0 {
+		constraint := "PRIMARY KEY (" + quoteList(d, description.PrimaryKey) + ")"
+		if d.Is("bigquery") {
+			constraint += " NOT ENFORCED"
+		}
+		definitions = append(definitions, constraint)
+	}
+	if !d.Is("bigquery") {
+		for _, columns := range description.Unique {
+			definitions = append(definitions, "UNIQUE ("+quoteList(d, columns)+")")
+		}
+		for _, fk := range description.ForeignKeys {
+			refTable := fk.RefTable
+			if qualify && fk.RefSchema != "" {
+				refTable = qualified(fk.RefSchema, refTable)
+			}
+			constraint := "FOREIGN KEY (" + quoteList(d, fk.Columns) + ") REFERENCES " + d.QuoteIdentifier(refTable) + " (" + quoteList(d, fk.RefColumns) + ")"
+			if fk.OnDelete != "" {
+				constraint += " ON DELETE " + fk.OnDelete
+			}
+			if fk.OnUpdate != "" && !d.Is("oracle") {
+				constraint += " ON UPDATE " + fk.OnUpdate
+			}
+			definitions = append(definitions, constraint)
+		}
+	}
+	DDL := "CREATE TABLE " + d.QuoteIdentifier(name) + " (\n  " + strings.Join(definitions, ",\n  ") + "\n)"
+	if d.Is("bigquery") {
+		return DDL
+	}
+	statements := []string{DDL}
+	for _, index := range description.Indexes {
+		if index.Unique {
+			continue // emitted as UNIQUE constraint
+		}
+		indexName := index.Name
+		if qualify && d.Is("postgres", "oracle") {
+			indexName = qualified(description.Schema, indexName)
+		}
+		statements = append(statements, "CREATE INDEX "+d.QuoteIdentifier(indexName)+" ON "+d.QuoteIdentifier(name)+" ("+quoteList(d, index.Columns)+")")
+	}
+	return strings.Join(statements, ";\n") + ";"
+}
+
+// columnDeclaration returns column type, translated through portable type families when needed.
+func columnDeclaration(d *dialect.Dialect, column *ColumnInfo, translate bool) string {
+	if !translate {
+		return column.Type
+	}
+	family, size := dialect.ParseType(column.Type)
+	return d.TypeDeclaration(family, size...)
+}
+
+// columnDefinition returns column name, type, default and NOT NULL clause.
+func columnDefinition(d *dialect.Dialect, column *ColumnInfo, translate, notNull bool) string {
+	definition := d.QuoteIdentifier(column.Name) + " " + columnDeclaration(d, column, translate)
+	if column.Default != "" && (!translate || isLiteral(column.Default)) && !d.Is("bigquery") {
+		definition += " DEFAULT " + column.Default
+	}
+	if notNull {
+		definition += " NOT NULL"
+	}
+	return definition
+}
+
+func quoteList(d *dialect.Dialect, names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = d.QuoteIdentifier(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// isLiteral reports whether a column default is a portable numeric or string literal.
+func isLiteral(value string) bool {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") && !strings.Contains(value, "::") {
+		return true
+	}
+	if value == "" {
+		return false
+	}
+	for i, r := range value {
+		if (r < '0' || r > '9') && r != '.' && !(i == 0 && r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func qualified(schema, name string) string {
+	if schema == "" {
+		return name
+	}
+	return schema + "." + name
+}
+
+func nullable(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
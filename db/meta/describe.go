@@ -0,0 +1,359 @@
+package meta
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/sqlx/metadata"
+	"github.com/viant/sqlx/metadata/info"
+	"github.com/viant/sqlx/metadata/sink"
+)
+
+// DescribeTableInput defines parameters for describing a table structure.
+type DescribeTableInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Catalog/database name (optional).
+	Catalog string `json:"catalog,omitempty"`
+
+	// Schema name (optional – defaults to the connector schema).
+	Schema string `json:"schema,omitempty"`
+
+	// Table to describe.
+	Table string `json:"table"`
+
+	// Refresh bypasses the metadata cache and reloads it from the database catalog.
+	Refresh bool `json:"refresh,omitempty"`
+}
+
+// DescribeTableOutput wraps a table description.
+type DescribeTableOutput struct {
+	Data      *TableDescription `json:"data,omitempty"`
+	Cached    bool              `json:"cached,omitempty"`
+	Status    string            `json:"status"`
+	Error     string            `json:"error,omitempty"`
+	Connector string            `json:"connector,omitempty"`
+}
+
+// TableDescription is a compact view of table columns, keys, indexes and relationships.
+type TableDescription struct {
+	Schema      string        `json:"schema,omitempty"`
+	Table       string        `json:"table"`
+	Annotation  *Annotation   `json:"annotation,omitempty"`
+	Columns     []*ColumnInfo `json:"columns"`
+	PrimaryKey  []string      `json:"primaryKey,omitempty"`
+	Unique      [][]string    `json:"unique,omitempty"`
+	Indexes     []*IndexInfo  `json:"indexes,omitempty"`
+	ForeignKeys []*ForeignKey `json:"foreignKeys,omitempty"`
+}
+
+// ColumnInfo describes a column.
+type ColumnInfo struct {
+	Name       string      `json:"name"`
+	Type       string      `json:"type"`
+	Nullable   bool        `json:"nullable,omitempty"`
+	Default    string      `json:"default,omitempty"`
+	Comment    string      `json:"comment,omitempty"`
+	Annotation *Annotation `json:"annotation,omitempty"`
+}
+
+// IndexInfo describes an index with columns in index order.
+type IndexInfo struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Unique  bool     `json:"unique,omitempty"`
+	Type    string   `json:"type,omitempty"`
+}
+
+// ForeignKey describes a relationship to a referenced table.
+type ForeignKey struct {
+	Name       string   `json:"name,omitempty"`
+	Columns    []string `json:"columns"`
+	RefSchema  string   `json:"refSchema,omitempty"`
+	RefTable   string   `json:"refTable"`
+	RefColumns []string `json:"refColumns"`
+	OnUpdate   string   `json:"onUpdate,omitempty"`
+	OnDelete   string   `json:"onDelete,omitempty"`
+}
+
+// keyRow is a single key column; primary and foreign keys share the shape.
+type keyRow struct {
+	Name      string
+	Column    string
+	Position  int
+	RefSchema string
+	RefTable  string
+	RefColumn string
+	OnUpdate  string
+	OnDelete  string
+}
+
+// indexRow is a single index column.
+type indexRow struct {
+	Name     string
+	Unique   bool
+	Type     string
+	Primary  bool
+	Position int
+	Column   string
+}
+
+// DescribeTable returns columns, primary key, unique constraints, indexes and foreign keys of a table.
+func (s *Service) DescribeTable(ctx context.Context, input *DescribeTableInput) *DescribeTableOutput {
+	out := &DescribeTableOutput{Status: "ok"}
+	if input == nil {
+		input = &DescribeTableInput{}
+	}
+	if err := s.describeTable(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) describeTable(ctx context.Context, input *DescribeTableInput, out *DescribeTableOutput) error {
+	if input.Table == "" {
+		return fmt.Errorf("table was empty")
+	}
+	requestedConnector := input.Connector
+	conn, db, err := s.connection(ctx, requestedConnector)
+	if err != nil {
+		return err
+	}
+	s.applyDefaults(ctx, conn, db, &input.Catalog, &input.Schema)
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbDescribeTable start connector=%q driver=%q catalog=%q schema=%q table=%q refresh=%v", requestedConnector, conn.Driver, input.Catalog, input.Schema, input.Table, input.Refresh)
+	description, hit, err := s.describe(ctx, conn, db, input.Catalog, input.Schema, input.Table, input.Refresh)
+	if err != nil {
+		log.Printf("mcp-sqlkit dbDescribeTable error connector=%q driver=%q table=%q elapsed=%s err=%v", requestedConnector, conn.Driver, input.Table, time.Since(started), err)
+		return err
+	}
+	log.Printf("mcp-sqlkit dbDescribeTable done connector=%q driver=%q table=%q columns=%d cached=%v elapsed=%s", requestedConnector, conn.Driver, input.Table, len(description.Columns), hit, time.Since(started))
+	out.Connector = requestedConnector
+	out.Cached = hit
+	out.Data = annotated(description, s.tableAnnotation(ctx, conn.Name, input.Schema, input.Table))
+	return nil
+}
+
+// describe returns table description, served from the cache unless refresh is set.
+func (s *Service) describe(ctx context.Context, conn *connector.Connector, db *sql.DB, catalog, schema, table string, refresh bool) (*TableDescription, bool, error) {
+	return cached(ctx, s, conn, refresh, func() (*TableDescription, error) {
+		return s.loadDescription(ctx, conn, db, catalog, schema, table)
+	}, "description", catalog, schema, table)
+}
+
+func (s *Service) loadDescription(ctx context.Context, conn *connector.Connector, db *sql.DB, catalog, schema, table string) (*TableDescription, error) {
+	m := metadata.New()
+	var columns []sink.Column
+	if err := m.Info(ctx, db, info.KindTable, &columns, s.metadataOptions(conn, catalog, schema, table)...); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %v not found", table)
+	}
+	sort.SliceStable(columns, func(i, j int) bool { return columns[i].Position < columns[j].Position })
+	ret := &TableDescription{Schema: schema, Table: table}
+	for i := range columns {
+		ret.Columns = append(ret.Columns, columnInfo(&columns[i]))
+	}
+
+	var primaryKey, foreignKeys []keyRow
+	var indexes []indexRow
+	var err error
+	if queries, ok := catalogQueries(conn.Driver); ok {
+		args := queries.args(schema, table)
+		if primaryKey, err = queryKeys(ctx, db, queries.primaryKey, args); err != nil {
+			return nil, err
+		}
+		if foreignKeys, err = queryKeys(ctx, db, queries.foreignKeys, args); err != nil {
+			return nil, err
+		}
+		if indexes, err = queryIndexes(ctx, db, queries.indexes, args); err != nil {
+			return nil, err
+		}
+	} else {
+		// metadata kinds are optional per product – a missing query must not fail the whole description
+		options := s.metadataOptions(conn, catalog, schema, table)
+		var keys []sink.Key
+		if m.Info(ctx, db, info.KindPrimaryKeys, &keys, options...) == nil {
+			primaryKey = fromSinkKeys(keys)
+		}
+		keys = nil
+		if m.Info(ctx, db, info.KindForeignKeys, &keys, options...) == nil {
+			foreignKeys = fromSinkKeys(keys)
+		}
+		var sinkIndexes []sink.Index
+		if m.Info(ctx, db, info.KindIndexes, &sinkIndexes, options...) == nil {
+			indexes = fromSinkIndexes(sinkIndexes)
+		}
+	}
+
+	sortKeys(primaryKey)
+	for _, row := range primaryKey {
+		ret.PrimaryKey = append(ret.PrimaryKey, row.Column)
+	}
+	ret.ForeignKeys = buildForeignKeys(foreignKeys)
+	ret.Indexes, ret.Unique = buildIndexes(indexes, ret.PrimaryKey)
+	return ret, nil
+}
+
+func columnInfo(column *sink.Column) *ColumnInfo {
+	ret := &ColumnInfo{Name: column.Name, Type: column.Type, Comment: column.Comments}
+	switch strings.ToUpper(column.Nullable) {
+	case "YES", "Y", "1", "TRUE":
+		ret.Nullable = true
+	}
+	if column.Default != nil {
+		ret.Default = *column.Default
+	}
+	if strings.Contains(ret.Type, "(") {
+		return ret
+	}
+	if column.Length != nil && *column.Length > 0 {
+		ret.Type = fmt.Sprintf("%v(%v)", ret.Type, *column.Length)
+	} else if column.Precision != nil && *column.Precision > 0 && column.Scale != nil && *column.Scale > 0 {
+		ret.Type = fmt.Sprintf("%v(%v,%v)", ret.Type, *column.Precision, *column.Scale)
+	}
+	return ret
+}
+
+func queryKeys(ctx context.Context, db *sql.DB, SQL string, args []interface{}) ([]keyRow, error) {
+	rows, err := db.QueryContext(ctx, SQL, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var result []keyRow
+	for rows.Next() {
+		row := keyRow{}
+		var refSchema, refTable, refColumn, onUpdate, onDelete sql.NullString
+		targets := []interface{}{&row.Name, &row.Column, &row.Position, &refSchema, &refTable, &refColumn, &onUpdate, &onDelete}
+		if err = rows.Scan(targets[:len(columns)]...); err != nil {
+			return nil, err
+		}
+		row.RefSchema, row.RefTable, row.RefColumn = refSchema.String, refTable.String, refColumn.String
+		row.OnUpdate, row.OnDelete = onUpdate.String, onDelete.String
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+func queryIndexes(ctx context.Context, db *sql.DB, SQL string, args []interface{}) ([]indexRow, error) {
+	rows, err := db.QueryContext(ctx, SQL, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []indexRow
+	for rows.Next() {
+		row := indexRow{}
+		var indexType sql.NullString
+		if err = rows.Scan(&row.Name, &row.Unique, &indexType, &row.Primary, &row.Position, &row.Column); err != nil {
+			return nil, err
+		}
+		row.Type = indexType.String
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+func fromSinkKeys(keys []sink.Key) []keyRow {
+	var result []keyRow
+	for _, key := range keys {
+		result = append(result, keyRow{Name: key.Name, Column: key.Column, Position: key.Position,
+			RefSchema: key.ReferenceSchema, RefTable: key.ReferenceTable, RefColumn: key.ReferenceColumn,
+			OnUpdate: key.OnUpdate, OnDelete: key.OnDelete})
+	}
+	return result
+}
+
+func fromSinkIndexes(indexes []sink.Index) []indexRow {
+	var result []indexRow
+	for _, index := range indexes {
+		for i, column := range strings.Split(index.Columns, ",") {
+			result = append(result, indexRow{Name: index.Name, Unique: index.Unique == "1" || strings.EqualFold(index.Unique, "true"),
+				Type: index.Type, Primary: strings.EqualFold(index.Origin, "pk"), Position: i + 1, Column: strings.TrimSpace(column)})
+		}
+	}
+	return result
+}
+
+func sortKeys(rows []keyRow) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Name != rows[j].Name {
+			return rows[i].Name < rows[j].Name
+		}
+		return rows[i].Position < rows[j].Position
+	})
+}
+
+func buildForeignKeys(rows []keyRow) []*ForeignKey {
+	sortKeys(rows)
+	var result []*ForeignKey
+	var current *ForeignKey
+	for _, row := range rows {
+		if current == nil || current.Name != row.Name {
+			current = &ForeignKey{Name: row.Name, RefSchema: row.RefSchema, RefTable: row.RefTable, OnUpdate: normalizeRule(row.OnUpdate), OnDelete: normalizeRule(row.OnDelete)}
+			result = append(result, current)
+		}
+		current.Columns = append(current.Columns, row.Column)
+		current.RefColumns = append(current.RefColumns, row.RefColumn)
+	}
+	return result
+}
+
+// normalizeRule omits default referential actions to keep the output compact.
+func normalizeRule(rule string) string {
+	switch strings.ToUpper(rule) {
+	case "", "NO ACTION", "NONE", "RESTRICT":
+		return ""
+	}
+	return strings.ToUpper(rule)
+}
+
+// buildIndexes groups index rows and derives unique constraints; primary key indexes are omitted.
+func buildIndexes(rows []indexRow, primaryKey []string) ([]*IndexInfo, [][]string) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Name != rows[j].Name {
+			return rows[i].Name < rows[j].Name
+		}
+		return rows[i].Position < rows[j].Position
+	})
+	var indexes []*IndexInfo
+	var primary = map[string]bool{}
+	var current *IndexInfo
+	for _, row := range rows {
+		if current == nil || current.Name != row.Name {
+			current = &IndexInfo{Name: row.Name, Unique: row.Unique, Type: row.Type}
+			indexes = append(indexes, current)
+		}
+		if row.Primary {
+			primary[row.Name] = true
+		}
+		current.Columns = append(current.Columns, row.Column)
+	}
+	var result []*IndexInfo
+	var unique [][]string
+	pk := strings.Join(primaryKey, ",")
+	for _, index := range indexes {
+		if primary[index.Name] || (index.Unique && strings.Join(index.Columns, ",") == pk) {
+			continue
+		}
+		if index.Unique {
+			unique = append(unique, index.Columns)
+		}
+		result = append(result, index)
+	}
+	return result, unique
+}
@@ -0,0 +1,240 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatMermaid renders a Mermaid erDiagram.
+	FormatMermaid = "mermaid"
+	// FormatDOT renders a Graphviz digraph.
+	FormatDOT = "dot"
+
+	maxDiagramTables = 100
+)
+
+// DiagramInput defines parameters for rendering an entity-relationship diagram.
+type DiagramInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Catalog/database name (optional).
+	Catalog string `json:"catalog,omitempty"`
+
+	// Schema name (optional – defaults to the connector schema).
+	Schema string `json:"schema,omitempty"`
+
+	// Tables limits the diagram to the listed tables (optional).
+	Tables []string `json:"tables,omitempty"`
+
+	// Pattern filters table names (substring, glob * ? or SQL LIKE %).
+	Pattern string `json:"pattern,omitempty"`
+
+	// Format of the diagram (default mermaid).
+	Format string `json:"format,omitempty" choice:"mermaid" choice:"dot"`
+
+	// KeysOnly renders only primary and foreign key columns.
+	KeysOnly bool `json:"keysOnly,omitempty"`
+
+	// Refresh bypasses the metadata cache and reloads it from the database catalog.
+	Refresh bool `json:"refresh,omitempty"`
+}
+
+// DiagramOutput wraps a rendered diagram.
+type DiagramOutput struct {
+	Data      *Diagram `json:"data,omitempty"`
+	Status    string   `json:"status"`
+	Error     string   `json:"error,omitempty"`
+	Connector string   `json:"connector,omitempty"`
+}
+
+// Diagram holds rendered diagram source.
+type Diagram struct {
+	Format        string `json:"format"`
+	Source        string `json:"source"`
+	Tables        int    `json:"tables"`
+	Relationships int    `json:"relationships"`
+}
+
+// Diagram renders tables, columns and foreign keys as Mermaid erDiagram or Graphviz DOT.
+func (s *Service) Diagram(ctx context.Context, input *DiagramInput) *DiagramOutput {
+	out := &DiagramOutput{Status: "ok"}
+	if input == nil {
+		input = &DiagramInput{}
+	}
+	if err := s.diagram(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) diagram(ctx context.Context, input *DiagramInput, out *DiagramOutput) error {
+	format := strings.ToLower(input.Format)
+	switch format {
+	case "":
+		format = FormatMermaid
+	case FormatMermaid, FormatDOT:
+	default:
+		return fmt.Errorf("unsupported diagram format: %v", input.Format)
+	}
+	requestedConnector := input.Connector
+	conn, db, err := s.connection(ctx, requestedConnector)
+	if err != nil {
+		return err
+	}
+	s.applyDefaults(ctx, conn, db, &input.Catalog, &input.Schema)
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbDiagram start connector=%q driver=%q catalog=%q schema=%q tables=%d", requestedConnector, conn.Driver, input.Catalog, input.Schema, len(input.Tables))
+
+	names := input.Tables
+	if len(names) == 0 {
+		tables, _, err := s.tables(ctx, conn, db, input.Catalog, input.Schema, input.Refresh)
+		if err != nil {
+			return err
+		}
+		for _, table := range filterTables(tables, input.Pattern, TableTypeTable) {
+			names = append(names, table.Name)
+		}
+		sort.Strings(names)
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no tables matched")
+	}
+	if len(names) > maxDiagramTables {
+		return fmt.Errorf("too many tables (%d > %d): narrow the diagram with tables or pattern", len(names), maxDiagramTables)
+	}
+	var descriptions []*TableDescription
+	for _, name := range names {
+		description, _, err := s.describe(ctx, conn, db, input.Catalog, input.Schema, name, input.Refresh)
+		if err != nil {
+			return err
+		}
+		descriptions = append(descriptions, description)
+	}
+	diagram := &Diagram{Format: format, Tables: len(descriptions)}
+	if format == FormatDOT {
+		diagram.Source, diagram.Relationships = renderDOT(descriptions, input.KeysOnly)
+	} else {
+		diagram.Source, diagram.Relationships = renderMermaid(descriptions, input.KeysOnly)
+	}
+	log.Printf("mcp-sqlkit dbDiagram done connector=%q driver=%q tables=%d relationships=%d elapsed=%s", requestedConnector, conn.Driver, diagram.Tables, diagram.Relationships, time.Since(started))
+	out.Connector = requestedConnector
+	out.Data = diagram
+	return nil
+}
+
+// columnKeys returns PK/FK/UK markers of table columns.
+func columnKeys(description *TableDescription) map[string][]string {
+	keys := map[string][]string{}
+	for _, column := range description.PrimaryKey {
+		keys[column] = append(keys[column], "PK")
+	}
+	for _, fk := range description.ForeignKeys {
+		for _, column := range fk.Columns {
+			if !slices.Contains(keys[column], "FK") {
+				keys[column] = append(keys[column], "FK")
+			}
+		}
+	}
+	for _, unique := range description.Unique {
+		if len(unique) == 1 && !slices.Contains(keys[unique[0]], "PK") && !slices.Contains(keys[unique[0]], "UK") {
+			keys[unique[0]] = append(keys[unique[0]], "UK")
+		}
+	}
+	return keys
+}
+
+func renderMermaid(descriptions []*TableDescription, keysOnly bool) (string, int) {
+	builder := &strings.Builder{}
+	builder.WriteString("erDiagram\n")
+	relationships := 0
+	for _, description := range descriptions {
+		keys := columnKeys(description)
+		builder.WriteString("    " + mermaidName(description.Table) + " {\n")
+		for _, column := range description.Columns {
+			if keysOnly && len(keys[column.Name]) == 0 {
+				continue
+			}
+			builder.WriteString("        " + mermaidName(column.Type) + " " + mermaidName(column.Name))
+			if len(keys[column.Name]) > 0 {
+				builder.WriteString(" " + strings.Join(keys[column.Name], ", "))
+			}
+			builder.WriteString("\n")
+		}
+		builder.WriteString("    }\n")
+	}
+	for _, description := range descriptions {
+		nullable := map[string]bool{}
+		for _, column := range description.Columns {
+			nullable[column.Name] = column.Nullable
+		}
+		for _, fk := range description.ForeignKeys {
+			// child many-to-one parent; optional parent when any FK column is nullable
+			parent := "||"
+			for _, column := range fk.Columns {
+				if nullable[column] {
+					parent = "o|"
+				}
+			}
+			builder.WriteString(fmt.Sprintf("    %v }o--%v %v : \"%v\"\n", mermaidName(description.Table), parent, mermaidName(fk.RefTable), strings.Join(fk.Columns, ", ")))
+			relationships++
+		}
+	}
+	return builder.String(), relationships
+}
+
+// mermaidName converts identifiers and types to Mermaid safe tokens.
+func mermaidName(name string) string {
+	builder := strings.Builder{}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+			builder.WriteRune(r)
+		default:
+			builder.WriteRune('_')
+		}
+	}
+	result := strings.Trim(builder.String(), "_")
+	if result == "" {
+		return "_"
+	}
+	return result
+}
+
+func renderDOT(descriptions []*TableDescription, keysOnly bool) (string, int) {
+	builder := &strings.Builder{}
+	builder.WriteString("digraph schema {\n  rankdir=LR;\n  node [shape=plaintext];\n")
+	relationships := 0
+	for _, description := range descriptions {
+		keys := columnKeys(description)
+		builder.WriteString(fmt.Sprintf("  %q [label=<<TABLE BORDER=\"0\" CELLBORDER=\"1\" CELLSPACING=\"0\">", description.Table))
+		builder.WriteString("<TR><TD BGCOLOR=\"lightgrey\"><B>" + html.EscapeString(description.Table) + "</B></TD></TR>")
+		for _, column := range description.Columns {
+			if keysOnly && len(keys[column.Name]) == 0 {
+				continue
+			}
+			label := column.Name + " " + column.Type
+			if len(keys[column.Name]) > 0 {
+				label += " " + strings.Join(keys[column.Name], ", ")
+			}
+			builder.WriteString(fmt.Sprintf("<TR><TD ALIGN=\"LEFT\" PORT=%q>%v</TD></TR>", column.Name, html.EscapeString(label)))
+		}
+		builder.WriteString("</TABLE>>];\n")
+	}
+	for _, description := range descriptions {
+		for _, fk := range description.ForeignKeys {
+			builder.WriteString(fmt.Sprintf("  %q:%q -> %q:%q [label=%q];\n", description.Table, fk.Columns[0], fk.RefTable, fk.RefColumns[0], strings.Join(fk.Columns, ", ")))
+			relationships++
+		}
+	}
+	builder.WriteString("}\n")
+	return builder.String(), relationships
+}
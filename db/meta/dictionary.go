@@ -0,0 +1,231 @@
+package meta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/viant/afs/file"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+)
+
+const (
+	// FormatMarkdown renders a Markdown document.
+	FormatMarkdown = "markdown"
+	// FormatJSON renders a JSON document.
+	FormatJSON = "json"
+
+	maxDictionaryTables = 500
+)
+
+// DataDictionaryInput defines parameters for generating a data dictionary.
+type DataDictionaryInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Catalog/database name (optional).
+	Catalog string `json:"catalog,omitempty"`
+
+	// Schema name (optional – defaults to the connector schema).
+	Schema string `json:"schema,omitempty"`
+
+	// Tables limits the dictionary to the listed tables (optional).
+	Tables []string `json:"tables,omitempty"`
+
+	// Pattern filters table names (substring, glob * ? or SQL LIKE %).
+	Pattern string `json:"pattern,omitempty"`
+
+	// Type filters by table type: table or view (optional – both by default).
+	Type string `json:"type,omitempty" choice:"table" choice:"view"`
+
+	// Format of the dictionary (default markdown).
+	Format string `json:"format,omitempty" choice:"markdown" choice:"json"`
+
+	// URL writes the dictionary to an afs location (file://, mem://, gs://, s3://) instead of returning it.
+	URL string `json:"url,omitempty"`
+
+	// Refresh bypasses the metadata cache and reloads it from the database catalog.
+	Refresh bool `json:"refresh,omitempty"`
+}
+
+// DataDictionaryOutput wraps a data dictionary; content is omitted when written to URL.
+type DataDictionaryOutput struct {
+	Data      *DataDictionary `json:"data,omitempty"`
+	Markdown  string          `json:"markdown,omitempty"`
+	URL       string          `json:"url,omitempty"`
+	Tables    int             `json:"tables"`
+	Status    string          `json:"status"`
+	Error     string          `json:"error,omitempty"`
+	Connector string          `json:"connector,omitempty"`
+}
+
+// DataDictionary documents tables of a connector schema.
+type DataDictionary struct {
+	Connector   string             `json:"connector"`
+	Catalog     string             `json:"catalog,omitempty"`
+	Schema      string             `json:"schema,omitempty"`
+	GeneratedAt string             `json:"generatedAt"`
+	Tables      []*DictionaryTable `json:"tables"`
+}
+
+// DictionaryTable is a table description with type, comment and approximate row count.
+type DictionaryTable struct {
+	*TableDescription
+	Type    string `json:"type"`
+	Comment string `json:"comment,omitempty"`
+	Rows    *int64 `json:"rows,omitempty"`
+}
+
+// DataDictionary documents tables, columns, types, keys, comments, annotations and row estimates
+// of a schema as Markdown or JSON, optionally writing the document to an afs URL.
+func (s *Service) DataDictionary(ctx context.Context, input *DataDictionaryInput) *DataDictionaryOutput {
+	out := &DataDictionaryOutput{Status: "ok"}
+	if input == nil {
+		input = &DataDictionaryInput{}
+	}
+	if err := s.dataDictionary(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) dataDictionary(ctx context.Context, input *DataDictionaryInput, out *DataDictionaryOutput) error {
+	format := strings.ToLower(input.Format)
+	switch format {
+	case "", "md":
+		format = FormatMarkdown
+	case FormatMarkdown, FormatJSON:
+	default:
+		return fmt.Errorf("unsupported data dictionary format: %v", input.Format)
+	}
+	requestedConnector := input.Connector
+	conn, db, err := s.connection(ctx, requestedConnector)
+	if err != nil {
+		return err
+	}
+	s.applyDefaults(ctx, conn, db, &input.Catalog, &input.Schema)
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbDataDictionary start connector=%q driver=%q catalog=%q schema=%q format=%q url=%q", requestedConnector, conn.Driver, input.Catalog, input.Schema, format, input.URL)
+
+	tables, _, err := s.tables(ctx, conn, db, input.Catalog, input.Schema, input.Refresh)
+	if err != nil {
+		return err
+	}
+	tables = filterTables(tables, input.Pattern, input.Type)
+	sort.SliceStable(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	rows := map[string]*int64{}
+	// row estimates are best effort – not every driver exposes catalog statistics
+	if stats, err := s.loadStats(ctx, conn, db, dialect.Of(conn.Driver), input.Catalog, input.Schema); err == nil {
+		for _, item := range stats {
+			rows[strings.ToLower(item.Table)] = item.Rows
+		}
+	}
+	annotations := s.connectorAnnotations(ctx, conn.Name)
+	dictionary := &DataDictionary{Connector: conn.Name, Catalog: input.Catalog, Schema: input.Schema, GeneratedAt: time.Now().UTC().Format(time.RFC3339)}
+	for i := range tables {
+		table := &tables[i]
+		if len(input.Tables) > 0 && !containsFold(input.Tables, table.Name) {
+			continue
+		}
+		if len(dictionary.Tables) == maxDictionaryTables {
+			return fmt.Errorf("too many tables (> %d): narrow the dictionary with tables, pattern or type", maxDictionaryTables)
+		}
+		description, _, err := s.describe(ctx, conn, db, input.Catalog, input.Schema, table.Name, input.Refresh)
+		if err != nil {
+			return err
+		}
+		entry := &DictionaryTable{
+			TableDescription: annotated(description, annotations.Table(input.Schema, table.Name)),
+			Type:             tableType(table),
+			Rows:             rows[strings.ToLower(table.Name)],
+		}
+		if table.Comment != nil {
+			entry.Comment = *table.Comment
+		}
+		dictionary.Tables = append(dictionary.Tables, entry)
+	}
+	if len(dictionary.Tables) == 0 {
+		return fmt.Errorf("no tables matched")
+	}
+	out.Connector = requestedConnector
+	out.Tables = len(dictionary.Tables)
+	if input.URL == "" {
+		if format == FormatJSON {
+			out.Data = dictionary
+		} else {
+			out.Markdown = dictionary.Markdown()
+		}
+		log.Printf("mcp-sqlkit dbDataDictionary done connector=%q driver=%q tables=%d elapsed=%s", requestedConnector, conn.Driver, out.Tables, time.Since(started))
+		return nil
+	}
+	content := dictionary.Markdown()
+	if format == FormatJSON {
+		data, err := json.MarshalIndent(dictionary, "", "  ")
+		if err != nil {
+			return err
+		}
+		content = string(data)
+	}
+	if err = s.fs.Upload(ctx, input.URL, file.DefaultFileOsMode, strings.NewReader(content)); err != nil {
+		log.Printf("mcp-sqlkit dbDataDictionary error connector=%q driver=%q url=%q elapsed=%s err=%v", requestedConnector, conn.Driver, input.URL, time.Since(started), err)
+		return fmt.Errorf("failed to write data dictionary to %v: %w", input.URL, err)
+	}
+	log.Printf("mcp-sqlkit dbDataDictionary done connector=%q driver=%q tables=%d url=%q elapsed=%s", requestedConnector, conn.Driver, out.Tables, input.URL, time.Since(started))
+	out.URL = input.URL
+	return nil
+}
+
+// Markdown renders the dictionary with a table of contents and one section per table.
+func (d *DataDictionary) Markdown() string {
+	builder := &strings.Builder{}
+	title := d.Connector
+	if d.Schema != "" {
+		title += " / " + d.Schema
+	}
+	builder.WriteString("# Data dictionary: " + title + "\n\n")
+	builder.WriteString("Generated " + d.GeneratedAt + ", " + strconv.Itoa(len(d.Tables)) + " tables.\n\n")
+	builder.WriteString("| Table | Type | Rows (est.) | Description |\n|---|---|---|---|\n")
+	for _, table := range d.Tables {
+		builder.WriteString(fmt.Sprintf("| [%v](#%v) | %v | %v | %v |\n", markdownCell(table.Table), markdownAnchor(table.qualifiedName()), table.Type, rowsCell(table.Rows), markdownCell(table.summary())))
+	}
+	for _, table := range d.Tables {
+		builder.WriteString("\n## " + table.qualifiedName() + "\n\n")
+		if table.Comment != "" {
+			builder.WriteString(table.Comment + "\n\n")
+		}
+		if table.Type == TableTypeView {
+			builder.WriteString("View.\n\n")
+		}
+		if table.Rows != nil {
+			builder.WriteString("Rows (estimate): " + strconv.FormatInt(*table.Rows, 10) + "\n\n")
+		}
+		writeTableMarkdown(builder, table.TableDescription, 2)
+	}
+	return builder.String()
+}
+
+// summary returns the annotated description or the catalog comment.
+func (t *DictionaryTable) summary() string {
+	if t.Annotation != nil && t.Annotation.Description != "" {
+		return t.Annotation.Description
+	}
+	return t.Comment
+}
+
+func rowsCell(rows *int64) string {
+	if rows == nil {
+		return ""
+	}
+	return strconv.FormatInt(*rows, 10)
+}
+
+// markdownAnchor returns the GitHub style anchor of a heading.
+func markdownAnchor(heading string) string {
+	return strings.NewReplacer(".", "", " ", "-").Replace(strings.ToLower(heading))
+}
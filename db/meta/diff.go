@@ -0,0 +1,611 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+)
+
+const (
+	// ChangeAdded marks objects present in the source only.
+	ChangeAdded = "added"
+	// ChangeRemoved marks objects present in the target only.
+	ChangeRemoved = "removed"
+	// ChangeChanged marks objects present on both sides with differences.
+	ChangeChanged = "changed"
+
+	maxDiffTables = 200
+)
+
+// SchemaLocation identifies a connector schema.
+type SchemaLocation struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Catalog/database name (optional).
+	Catalog string `json:"catalog,omitempty"`
+
+	// Schema name (optional – defaults to the connector schema).
+	Schema string `json:"schema,omitempty"`
+}
+
+// SchemaDiffInput defines parameters for comparing two schemas.
+type SchemaDiffInput struct {
+	// Source schema, e.g. staging – the desired state.
+	Source SchemaLocation `json:"source"`
+
+	// Target schema, e.g. production – the schema migration SQL applies to.
+	Target SchemaLocation `json:"target"`
+
+	// Tables limits comparison to the listed tables (optional).
+	Tables []string `json:"tables,omitempty"`
+
+	// Pattern filters table names (substring, glob * ? or SQL LIKE %).
+	Pattern string `json:"pattern,omitempty"`
+
+	// Migration generates SQL in the target dialect that brings target in line with source.
+	Migration bool `json:"migration,omitempty"`
+
+	// AllowDrop emits destructive statements (DROP TABLE/COLUMN/INDEX/CONSTRAINT) instead of commenting them out.
+	AllowDrop bool `json:"allowDrop,omitempty"`
+
+	// Refresh bypasses the metadata cache and reloads it from the database catalogs.
+	Refresh bool `json:"refresh,omitempty"`
+}
+
+// SchemaDiffOutput wraps a structured schema diff.
+type SchemaDiffOutput struct {
+	Data   *SchemaDiff `json:"data,omitempty"`
+	Status string      `json:"status"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// SchemaDiff lists table differences between source and target.
+type SchemaDiff struct {
+	Source    string       `json:"source"`
+	Target    string       `json:"target"`
+	Tables    []*TableDiff `json:"tables,omitempty"`
+	Summary   DiffSummary  `json:"summary"`
+	Migration string       `json:"migration,omitempty"`
+}
+
+// DiffSummary counts compared tables by change.
+type DiffSummary struct {
+	Added     int `json:"added"`
+	Removed   int `json:"removed"`
+	Changed   int `json:"changed"`
+	Unchanged int `json:"unchanged"`
+}
+
+// TableDiff describes differences of a table.
+type TableDiff struct {
+	Table       string            `json:"table"`
+	Change      string            `json:"change"`
+	Columns     []*ColumnDiff     `json:"columns,omitempty"`
+	PrimaryKey  *PrimaryKeyDiff   `json:"primaryKey,omitempty"`
+	Indexes     []*IndexDiff      `json:"indexes,omitempty"`
+	ForeignKeys []*ForeignKeyDiff `json:"foreignKeys,omitempty"`
+
+	source, target *TableDescription
+}
+
+// ColumnDiff describes a column difference; Fields lists changed attributes (type, nullable, default).
+type ColumnDiff struct {
+	Column string      `json:"column"`
+	Change string      `json:"change"`
+	Fields []string    `json:"fields,omitempty"`
+	Source *ColumnInfo `json:"source,omitempty"`
+	Target *ColumnInfo `json:"target,omitempty"`
+}
+
+// PrimaryKeyDiff describes differing primary key columns.
+type PrimaryKeyDiff struct {
+	Source []string `json:"source,omitempty"`
+	Target []string `json:"target,omitempty"`
+}
+
+// IndexDiff describes an index present on one side only.
+type IndexDiff struct {
+	Change string     `json:"change"`
+	Index  *IndexInfo `json:"index"`
+}
+
+// ForeignKeyDiff describes a foreign key present on one side only.
+type ForeignKeyDiff struct {
+	Change     string      `json:"change"`
+	ForeignKey *ForeignKey `json:"foreignKey"`
+}
+
+// side holds resolved connection state of a compared schema.
+type side struct {
+	location *SchemaLocation
+	conn     *connector.Connector
+	dialect  *dialect.Dialect
+	tables   map[string]string // lower-cased name -> name
+}
+
+// SchemaDiff compares tables, columns, indexes and keys of two connector schemas.
+func (s *Service) SchemaDiff(ctx context.Context, input *SchemaDiffInput) *SchemaDiffOutput {
+	out := &SchemaDiffOutput{Status: "ok"}
+	if input == nil {
+		input = &SchemaDiffInput{}
+	}
+	if err := s.schemaDiff(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) schemaDiff(ctx context.Context, input *SchemaDiffInput, out *SchemaDiffOutput) error {
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbSchemaDiff start source=%q/%q target=%q/%q", input.Source.Connector, input.Source.Schema, input.Target.Connector, input.Target.Schema)
+	// migration SQL is qualified with a requested target schema; the connection default needs no qualifier
+	targetSchema := input.Target.Schema
+	source, err := s.diffSide(ctx, &input.Source, input)
+	if err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+	target, err := s.diffSide(ctx, &input.Target, input)
+	if err != nil {
+		return fmt.Errorf("target: %w", err)
+	}
+	keys := map[string]bool{}
+	for key := range source.tables {
+		keys[key] = true
+	}
+	for key := range target.tables {
+		keys[key] = true
+	}
+	if len(keys) > maxDiffTables {
+		return fmt.Errorf("too many tables (%d > %d): narrow the diff with tables or pattern", len(keys), maxDiffTables)
+	}
+	names := make([]string, 0, len(keys))
+	for key := range keys {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+
+	diff := &SchemaDiff{Source: source.label(), Target: target.label()}
+	for _, key := range names {
+		tableDiff := &TableDiff{}
+		if name, ok := source.tables[key]; ok {
+			tableDiff.Table = name
+			if tableDiff.source, err = s.diffDescribe(ctx, source, name, input.Refresh); err != nil {
+				return err
+			}
+		}
+		if name, ok := target.tables[key]; ok {
+			if tableDiff.Table == "" {
+				tableDiff.Table = name
+			}
+			if tableDiff.target, err = s.diffDescribe(ctx, target, name, input.Refresh); err != nil {
+				return err
+			}
+		}
+		switch {
+		case tableDiff.target == nil:
+			tableDiff.Change = ChangeAdded
+			diff.Summary.Added++
+		case tableDiff.source == nil:
+			tableDiff.Change = ChangeRemoved
+			diff.Summary.Removed++
+		default:
+			if !compareTables(tableDiff, source.dialect.Driver != target.dialect.Driver) {
+				diff.Summary.Unchanged++
+				continue
+			}
+			tableDiff.Change = ChangeChanged
+			diff.Summary.Changed++
+		}
+		diff.Tables = append(diff.Tables, tableDiff)
+	}
+	if input.Migration {
+		diff.Migration = migrationSQL(diff.Tables, target.dialect, targetSchema, source.dialect.Driver != target.dialect.Driver, input.AllowDrop)
+	}
+	log.Printf("mcp-sqlkit dbSchemaDiff done source=%q target=%q added=%d removed=%d changed=%d unchanged=%d elapsed=%s", diff.Source, diff.Target, diff.Summary.Added, diff.Summary.Removed, diff.Summary.Changed, diff.Summary.Unchanged, time.Since(started))
+	out.Data = diff
+	return nil
+}
+
+func (s *Service) diffSide(ctx context.Context, location *SchemaLocation, input *SchemaDiffInput) (*side, error) {
+	conn, db, err := s.connection(ctx, location.Connector)
+	if err != nil {
+		return nil, err
+	}
+	s.applyDefaults(ctx, conn, db, &location.Catalog, &location.Schema)
+	tables, _, err := s.tables(ctx, conn, db, location.Catalog, location.Schema, input.Refresh)
+	if err != nil {
+		return nil, err
+	}
+	ret := &side{location: location, conn: conn, dialect: dialect.Of(conn.Driver), tables: map[string]string{}}
+	for _, table := range filterTables(tables, input.Pattern, TableTypeTable) {
+		if len(input.Tables) > 0 && !containsFold(input.Tables, table.Name) {
+			continue
+		}
+		ret.tables[strings.ToLower(table.Name)] = table.Name
+	}
+	return ret, nil
+}
+
+func (s *Service) diffDescribe(ctx context.Context, side *side, table string, refresh bool) (*TableDescription, error) {
+	db, err := side.conn.Db(ctx)
+	if err != nil {
+		return nil, err
+	}
+	description, _, err := s.describe(ctx, side.conn, db, side.location.Catalog, side.location.Schema, table, refresh)
+	return description, err
+}
+
+func (s *side) label() string {
+	return qualified(s.conn.Name, s.location.Schema)
+}
+
+// compareTables fills column, key and index differences; it reports whether any were found.
+func compareTables(diff *TableDiff, crossDriver bool) bool {
+	sourceColumns := map[string]*ColumnInfo{}
+	for _, column := range diff.source.Columns {
+		sourceColumns[strings.ToLower(column.Name)] = column
+	}
+	targetColumns := map[string]*ColumnInfo{}
+	for _, column := range diff.target.Columns {
+		targetColumns[strings.ToLower(column.Name)] = column
+	}
+	for _, column := range diff.source.Columns {
+		other, ok := targetColumns[strings.ToLower(column.Name)]
+		if !ok {
+			diff.Columns = append(diff.Columns, &ColumnDiff{Column: column.Name, Change: ChangeAdded, Source: column})
+			continue
+		}
+		if fields := compareColumns(column, other, diff.source.PrimaryKey, diff.target.PrimaryKey, crossDriver); len(fields) > 0 {
+			diff.Columns = append(diff.Columns, &ColumnDiff{Column: column.Name, Change: ChangeChanged, Fields: fields, Source: column, Target: other})
+		}
+	}
+	for _, column := range diff.target.Columns {
+		if _, ok := sourceColumns[strings.ToLower(column.Name)]; !ok {
+			diff.Columns = append(diff.Columns, &ColumnDiff{Column: column.Name, Change: ChangeRemoved, Target: column})
+		}
+	}
+	if !equalFold(diff.source.PrimaryKey, diff.target.PrimaryKey) {
+		diff.PrimaryKey = &PrimaryKeyDiff{Source: diff.source.PrimaryKey, Target: diff.target.PrimaryKey}
+	}
+	diff.Indexes = compareIndexes(diff.source.Indexes, diff.target.Indexes)
+	diff.ForeignKeys = compareForeignKeys(diff.source.ForeignKeys, diff.target.ForeignKeys)
+	return len(diff.Columns) > 0 || diff.PrimaryKey != nil || len(diff.Indexes) > 0 || len(diff.ForeignKeys) > 0
+}
+
+// compareColumns returns changed column attributes; types of different drivers compare by portable type family.
+func compareColumns(source, target *ColumnInfo, sourceKey, targetKey []string, crossDriver bool) []string {
+	var fields []string
+	if crossDriver {
+		sourceType, sourceSize := dialect.ParseType(source.Type)
+		targetType, targetSize := dialect.ParseType(target.Type)
+		if sourceType != targetType || !slices.Equal(sourceSize, targetSize) {
+			fields = append(fields, "type")
+		}
+	} else if !strings.EqualFold(strings.TrimSpace(source.Type), strings.TrimSpace(target.Type)) {
+		fields = append(fields, "type")
+	}
+	// primary key columns are implicitly NOT NULL (SQLite reports them nullable)
+	sourceNullable := source.Nullable && !containsFold(sourceKey, source.Name)
+	targetNullable := target.Nullable && !containsFold(targetKey, target.Name)
+	if sourceNullable != targetNullable {
+		fields = append(fields, "nullable")
+	}
+	if !crossDriver && strings.TrimSpace(source.Default) != strings.TrimSpace(target.Default) {
+		fields = append(fields, "default")
+	}
+	return fields
+}
+
+// compareIndexes matches indexes by uniqueness and columns since index names often differ between environments;
+// removed indexes are listed before added ones.
+func compareIndexes(source, target []*IndexInfo) []*IndexDiff {
+	signature := func(index *IndexInfo) string {
+		return fmt.Sprintf("%v:%v", index.Unique, strings.ToLower(strings.Join(index.Columns, ",")))
+	}
+	var result []*IndexDiff
+	targetSignatures := map[string]bool{}
+	for _, index := range target {
+		targetSignatures[signature(index)] = true
+	}
+	sourceSignatures := map[string]bool{}
+	for _, index := range source {
+		sourceSignatures[signature(index)] = true
+	}
+	for _, index := range target {
+		if !sourceSignatures[signature(index)] {
+			result = append(result, &IndexDiff{Change: ChangeRemoved, Index: index})
+		}
+	}
+	for _, index := range source {
+		if !targetSignatures[signature(index)] {
+			result = append(result, &IndexDiff{Change: ChangeAdded, Index: index})
+		}
+	}
+	return result
+}
+
+// compareForeignKeys matches foreign keys by columns, referenced table, columns and rules.
+func compareForeignKeys(source, target []*ForeignKey) []*ForeignKeyDiff {
+	signature := func(fk *ForeignKey) string {
+		return strings.ToLower(fmt.Sprintf("%v>%v(%v):%v:%v", strings.Join(fk.Columns, ","), fk.RefTable, strings.Join(fk.RefColumns, ","), fk.OnUpdate, fk.OnDelete))
+	}
+	var result []*ForeignKeyDiff
+	targetSignatures := map[string]bool{}
+	for _, fk := range target {
+		targetSignatures[signature(fk)] = true
+	}
+	sourceSignatures := map[string]bool{}
+	for _, fk := range source {
+		sourceSignatures[signature(fk)] = true
+		if !targetSignatures[signature(fk)] {
+			result = append(result, &ForeignKeyDiff{Change: ChangeAdded, ForeignKey: fk})
+		}
+	}
+	for _, fk := range target {
+		if !sourceSignatures[signature(fk)] {
+			result = append(result, &ForeignKeyDiff{Change: ChangeRemoved, ForeignKey: fk})
+		}
+	}
+	return result
+}
+
+// migrationSQL builds statements in the target dialect that apply source changes to target;
+// a non-empty schema qualifies target tables, indexes and referenced tables.
+func migrationSQL(tables []*TableDiff, d *dialect.Dialect, schema string, translate, allowDrop bool) string {
+	var statements []string
+	destructive := func(statement string) {
+		if !allowDrop {
+			statement = "-- " + statement
+		}
+		statements = append(statements, statement)
+	}
+	for _, table := range orderByReferences(tables) {
+		name := d.QuoteIdentifier(qualified(schema, table.Table))
+		switch table.Change {
+		case ChangeAdded:
+			statements = append(statements, strings.TrimSuffix(synthesizeDDL(inSchema(table.source, schema), d, translate, schema != ""), ";"))
+			continue
+		case ChangeRemoved:
+			destructive("DROP TABLE " + name)
+			continue
+		}
+		for _, column := range table.Columns {
+			switch column.Change {
+			case ChangeAdded:
+				definition := columnDefinition(d, column.Source, translate, !column.Source.Nullable && column.Source.Default != "")
+				statements = append(statements, addColumn(d, name, definition))
+			case ChangeRemoved:
+				destructive("ALTER TABLE " + name + " DROP COLUMN " + d.QuoteIdentifier(column.Column))
+			case ChangeChanged:
+				statements = append(statements, alterColumn(d, name, column, translate)...)
+			}
+		}
+		if table.PrimaryKey != nil {
+			statements = append(statements, primaryKeySQL(d, name, table.Table, table.PrimaryKey, allowDrop)...)
+		}
+		// drops go first so that an index keeping its name with other columns can be recreated
+		dropped := map[string]bool{}
+		for _, index := range table.Indexes {
+			if index.Change != ChangeRemoved {
+				continue
+			}
+			dropped[strings.ToLower(index.Index.Name)] = true
+			if d.Is("mysql") {
+				destructive("DROP INDEX " + d.QuoteIdentifier(index.Index.Name) + " ON " + name)
+			} else {
+				destructive("DROP INDEX " + d.QuoteIdentifier(qualified(schema, index.Index.Name)))
+			}
+		}
+		for _, index := range table.Indexes {
+			if index.Change != ChangeAdded {
+				continue
+			}
+			unique := ""
+			if index.Index.Unique {
+				unique = "UNIQUE "
+			}
+			// SQLite qualifies the index, Oracle both, Postgres and MySQL only the table
+			indexName, indexTable := index.Index.Name, name
+			switch d.Driver {
+			case "sqlite":
+				indexName, indexTable = qualified(schema, indexName), d.QuoteIdentifier(table.Table)
+			case "oracle":
+				indexName = qualified(schema, indexName)
+			}
+			statement := "CREATE " + unique + "INDEX " + d.QuoteIdentifier(indexName) + " ON " + indexTable + " (" + quoteList(d, index.Index.Columns) + ")"
+			if dropped[strings.ToLower(index.Index.Name)] && !allowDrop {
+				statement = "-- requires allowDrop (index " + index.Index.Name + " exists with other columns): " + statement
+			}
+			statements = append(statements, statement)
+		}
+		for _, fk := range table.ForeignKeys {
+			if d.Is("sqlite", "bigquery") {
+				statements = append(statements, fmt.Sprintf("-- %v foreign key %v (%v) requires recreating table %v", fk.Change, fk.ForeignKey.Name, strings.Join(fk.ForeignKey.Columns, ", "), table.Table))
+				continue
+			}
+			constraint := d.QuoteIdentifier(fk.ForeignKey.Name)
+			if fk.Change == ChangeRemoved {
+				if d.Is("mysql") {
+					destructive("ALTER TABLE " + name + " DROP FOREIGN KEY " + constraint)
+				} else {
+					destructive("ALTER TABLE " + name + " DROP CONSTRAINT " + constraint)
+				}
+				continue
+			}
+			statement := "ALTER TABLE " + name + " ADD CONSTRAINT " + constraint + " FOREIGN KEY (" + quoteList(d, fk.ForeignKey.Columns) + ") REFERENCES " +
+				d.QuoteIdentifier(qualified(refSchema(table.source, fk.ForeignKey, schema), fk.ForeignKey.RefTable)) + " (" + quoteList(d, fk.ForeignKey.RefColumns) + ")"
+			if fk.ForeignKey.OnDelete != "" {
+				statement += " ON DELETE " + fk.ForeignKey.OnDelete
+			}
+			if fk.ForeignKey.OnUpdate != "" && !d.Is("oracle") {
+				statement += " ON UPDATE " + fk.ForeignKey.OnUpdate
+			}
+			statements = append(statements, statement)
+		}
+	}
+	if len(statements) == 0 {
+		return ""
+	}
+	builder := strings.Builder{}
+	for _, statement := range statements {
+		builder.WriteString(statement)
+		if !strings.HasPrefix(statement, "--") {
+			builder.WriteString(";")
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// primaryKeySQL drops the target primary key and adds the source one; Postgres drops
+// the default <table>_pkey constraint, SQLite cannot change keys without recreating the table.
+// inSchema returns a copy of a source description placed in the target schema; references
+// to tables of the source schema follow it.
+func inSchema(description *TableDescription, schema string) *TableDescription {
+	if schema == "" {
+		return description
+	}
+	ret := *description
+	ret.Schema = schema
+	ret.ForeignKeys = make([]*ForeignKey, len(description.ForeignKeys))
+	for i, fk := range description.ForeignKeys {
+		moved := *fk
+		moved.RefSchema = refSchema(description, fk, schema)
+		ret.ForeignKeys[i] = &moved
+	}
+	return &ret
+}
+
+// refSchema returns the target schema of a referenced table: schema for references within
+// the source schema, the referenced schema otherwise.
+func refSchema(source *TableDescription, fk *ForeignKey, schema string) string {
+	if schema == "" || fk.RefSchema == "" || (source != nil && strings.EqualFold(fk.RefSchema, source.Schema)) {
+		return schema
+	}
+	return fk.RefSchema
+}
+
+func primaryKeySQL(d *dialect.Dialect, name, table string, diff *PrimaryKeyDiff, allowDrop bool) []string {
+	if d.Is("sqlite") {
+		return []string{fmt.Sprintf("-- primary key differs: source (%v), target (%v) requires recreating table %v", strings.Join(diff.Source, ", "), strings.Join(diff.Target, ", "), table)}
+	}
+	var result []string
+	if len(diff.Target) > 0 {
+		statement := "ALTER TABLE " + name + " DROP PRIMARY KEY"
+		if d.Is("postgres") {
+			statement = "ALTER TABLE " + name + " DROP CONSTRAINT " + d.QuoteIdentifier(table+"_pkey")
+		}
+		if !allowDrop {
+			statement = "-- " + statement
+		}
+		result = append(result, statement)
+	}
+	if len(diff.Source) > 0 {
+		statement := "ALTER TABLE " + name + " ADD PRIMARY KEY (" + quoteList(d, diff.Source) + ")"
+		if d.Is("bigquery") {
+			statement += " NOT ENFORCED"
+		}
+		if len(diff.Target) > 0 && !allowDrop {
+			statement = "-- requires allowDrop (replaces primary key " + strings.Join(diff.Target, ", ") + "): " + statement
+		}
+		result = append(result, statement)
+	}
+	return result
+}
+
+func addColumn(d *dialect.Dialect, table, definition string) string {
+	if d.Is("oracle") {
+		return "ALTER TABLE " + table + " ADD (" + definition + ")"
+	}
+	return "ALTER TABLE " + table + " ADD COLUMN " + definition
+}
+
+// alterColumn returns statements changing column type, nullability and default to the source definition.
+func alterColumn(d *dialect.Dialect, table string, diff *ColumnDiff, translate bool) []string {
+	column := d.QuoteIdentifier(diff.Column)
+	source := diff.Source
+	declaration := columnDeclaration(d, source, translate)
+	switch d.Driver {
+	case "mysql":
+		return []string{"ALTER TABLE " + table + " MODIFY COLUMN " + columnDefinition(d, source, translate, !source.Nullable)}
+	case "oracle":
+		definition := column + " " + declaration
+		if slices.Contains(diff.Fields, "nullable") {
+			if source.Nullable {
+				definition += " NULL"
+			} else {
+				definition += " NOT NULL"
+			}
+		}
+		return []string{"ALTER TABLE " + table + " MODIFY (" + definition + ")"}
+	case "postgres", "bigquery":
+		var result []string
+		prefix := "ALTER TABLE " + table + " ALTER COLUMN " + column
+		for _, field := range diff.Fields {
+			switch {
+			case field == "type" && d.Is("bigquery"):
+				result = append(result, prefix+" SET DATA TYPE "+declaration)
+			case field == "type":
+				result = append(result, prefix+" TYPE "+declaration)
+			case field == "nullable" && source.Nullable:
+				result = append(result, prefix+" DROP NOT NULL")
+			case field == "nullable" && d.Is("postgres"):
+				result = append(result, prefix+" SET NOT NULL")
+			case field == "default" && source.Default == "":
+				result = append(result, prefix+" DROP DEFAULT")
+			case field == "default":
+				result = append(result, prefix+" SET DEFAULT "+source.Default)
+			}
+		}
+		return result
+	}
+	return []string{fmt.Sprintf("-- %v cannot alter column %v (%v): recreate table %v", d.Driver, diff.Column, strings.Join(diff.Fields, ", "), table)}
+}
+
+// orderByReferences places added tables after the added tables they reference.
+func orderByReferences(tables []*TableDiff) []*TableDiff {
+	added := map[string]*TableDiff{}
+	for _, table := range tables {
+		if table.Change == ChangeAdded {
+			added[strings.ToLower(table.Table)] = table
+		}
+	}
+	var result []*TableDiff
+	visited := map[*TableDiff]bool{}
+	var visit func(table *TableDiff)
+	visit = func(table *TableDiff) {
+		if visited[table] {
+			return
+		}
+		visited[table] = true
+		if table.Change == ChangeAdded {
+			for _, fk := range table.source.ForeignKeys {
+				if ref, ok := added[strings.ToLower(fk.RefTable)]; ok {
+					visit(ref)
+				}
+			}
+		}
+		result = append(result, table)
+	}
+	for _, table := range tables {
+		visit(table)
+	}
+	return result
+}
+
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(item string) bool { return strings.EqualFold(item, value) })
+}
+
+func equalFold(left, right []string) bool {
+	return slices.EqualFunc(left, right, strings.EqualFold)
+}
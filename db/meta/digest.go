@@ -0,0 +1,300 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DigestPriorityCentrality orders tables by the number of foreign keys they take part in.
+	DigestPriorityCentrality = "centrality"
+	// DigestPriorityKeyword orders tables by keyword relevance, then by centrality.
+	DigestPriorityKeyword = "keyword"
+
+	defaultDigestTokens = 2000
+	maxDigestTokens     = 50000
+	maxDigestTables     = 500
+	// charsPerToken approximates tokenizer density of identifier-heavy text.
+	charsPerToken = 4
+	// neighborWeight is the share of a keyword match passed on to tables joined by a foreign key.
+	neighborWeight = 0.5
+)
+
+// SchemaDigestInput defines parameters for a token-budgeted schema summary.
+type SchemaDigestInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Catalog/database name (optional).
+	Catalog string `json:"catalog,omitempty"`
+
+	// Schema name (optional – defaults to the connector schema).
+	Schema string `json:"schema,omitempty"`
+
+	// Pattern filters table names (substring, glob * ? or SQL LIKE %).
+	Pattern string `json:"pattern,omitempty"`
+
+	// Keyword prioritizes tables whose name, columns, comments or annotations match it (fuzzy).
+	Keyword string `json:"keyword,omitempty"`
+
+	// MaxTokens is the approximate token budget of the digest (default 2000, max 50000).
+	MaxTokens int `json:"maxTokens,omitempty"`
+
+	// Refresh bypasses the metadata cache and reloads it from the database catalog.
+	Refresh bool `json:"refresh,omitempty"`
+}
+
+// SchemaDigestOutput wraps a schema digest.
+type SchemaDigestOutput struct {
+	Data      *SchemaDigest `json:"data,omitempty"`
+	Status    string        `json:"status"`
+	Error     string        `json:"error,omitempty"`
+	Connector string        `json:"connector,omitempty"`
+}
+
+// SchemaDigest is a compact one-line-per-table schema summary.
+type SchemaDigest struct {
+	Digest   string `json:"digest"`
+	Priority string `json:"priority"`
+	Tables   int    `json:"tables"`
+	Omitted  int    `json:"omitted,omitempty"`
+	Tokens   int    `json:"tokens"`
+}
+
+// digestTable is a described table with its priority.
+type digestTable struct {
+	description *TableDescription
+	comment     string
+	degree      int
+	score       float64
+}
+
+// SchemaDigest summarizes tables as name(column TYPE, key markers) lines within a token budget,
+// most relevant tables first.
+func (s *Service) SchemaDigest(ctx context.Context, input *SchemaDigestInput) *SchemaDigestOutput {
+	out := &SchemaDigestOutput{Status: "ok"}
+	if input == nil {
+		input = &SchemaDigestInput{}
+	}
+	if err := s.schemaDigest(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) schemaDigest(ctx context.Context, input *SchemaDigestInput, out *SchemaDigestOutput) error {
+	budget := input.MaxTokens
+	switch {
+	case budget <= 0:
+		budget = defaultDigestTokens
+	case budget > maxDigestTokens:
+		budget = maxDigestTokens
+	}
+	requestedConnector := input.Connector
+	conn, db, err := s.connection(ctx, requestedConnector)
+	if err != nil {
+		return err
+	}
+	s.applyDefaults(ctx, conn, db, &input.Catalog, &input.Schema)
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbSchemaDigest start connector=%q driver=%q catalog=%q schema=%q keyword=%q maxTokens=%d", requestedConnector, conn.Driver, input.Catalog, input.Schema, input.Keyword, budget)
+
+	tables, _, err := s.tables(ctx, conn, db, input.Catalog, input.Schema, input.Refresh)
+	if err != nil {
+		return err
+	}
+	tables = filterTables(tables, input.Pattern, "")
+	if len(tables) == 0 {
+		return fmt.Errorf("no tables matched")
+	}
+	if len(tables) > maxDigestTables {
+		return fmt.Errorf("too many tables (%d > %d): narrow the digest with pattern", len(tables), maxDigestTables)
+	}
+	annotations := s.connectorAnnotations(ctx, conn.Name)
+	var candidates []*digestTable
+	byName := map[string]*digestTable{}
+	for i := range tables {
+		description, _, err := s.describe(ctx, conn, db, input.Catalog, input.Schema, tables[i].Name, input.Refresh)
+		if err != nil {
+			return err
+		}
+		candidate := &digestTable{description: annotated(description, annotations.Table(input.Schema, tables[i].Name))}
+		if tables[i].Comment != nil {
+			candidate.comment = *tables[i].Comment
+		}
+		candidates = append(candidates, candidate)
+		byName[strings.ToLower(description.Table)] = candidate
+	}
+	for _, candidate := range candidates {
+		for _, fk := range candidate.description.ForeignKeys {
+			candidate.degree++
+			if referenced := byName[strings.ToLower(fk.RefTable)]; referenced != nil && referenced != candidate {
+				referenced.degree++
+			}
+		}
+	}
+	digest := &SchemaDigest{Priority: DigestPriorityCentrality}
+	if input.Keyword != "" {
+		digest.Priority = DigestPriorityKeyword
+		scorer, err := newMatcher(SearchModeFuzzy, input.Keyword)
+		if err != nil {
+			return err
+		}
+		scoreDigestTables(candidates, byName, scorer)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		if candidates[i].degree != candidates[j].degree {
+			return candidates[i].degree > candidates[j].degree
+		}
+		return candidates[i].description.Table < candidates[j].description.Table
+	})
+	renderDigest(digest, candidates, budget*charsPerToken)
+	log.Printf("mcp-sqlkit dbSchemaDigest done connector=%q driver=%q tables=%d omitted=%d tokens=%d elapsed=%s", requestedConnector, conn.Driver, digest.Tables, digest.Omitted, digest.Tokens, time.Since(started))
+	out.Connector = requestedConnector
+	out.Data = digest
+	return nil
+}
+
+// scoreDigestTables scores tables by keyword match of the table, its columns, comments and annotations;
+// tables joined to a match by a foreign key inherit part of its score.
+func scoreDigestTables(candidates []*digestTable, byName map[string]*digestTable, scorer *matcher) {
+	for _, candidate := range candidates {
+		description := candidate.description
+		comment := candidate.comment
+		if description.Annotation != nil {
+			comment += " " + description.Annotation.Description
+		}
+		candidate.score, _ = scorer.scoreObject(description.Table, "", comment)
+		for _, column := range description.Columns {
+			columnComment := column.Comment
+			if column.Annotation != nil {
+				columnComment += " " + column.Annotation.Description
+			}
+			score, _ := scorer.scoreObject(column.Name, description.Table, columnComment)
+			candidate.score = max(candidate.score, 0.9*score)
+		}
+	}
+	direct := map[*digestTable]float64{}
+	for _, candidate := range candidates {
+		direct[candidate] = candidate.score
+	}
+	for _, candidate := range candidates {
+		for _, fk := range candidate.description.ForeignKeys {
+			referenced := byName[strings.ToLower(fk.RefTable)]
+			if referenced == nil {
+				continue
+			}
+			referenced.score = max(referenced.score, neighborWeight*direct[candidate])
+			candidate.score = max(candidate.score, neighborWeight*direct[referenced])
+		}
+	}
+}
+
+// renderDigest adds table lines until the character budget is reached; a table that does not
+// fit is reduced to its key columns before the digest is truncated.
+func renderDigest(digest *SchemaDigest, candidates []*digestTable, budget int) {
+	builder := &strings.Builder{}
+	for i, candidate := range candidates {
+		remaining := len(candidates) - i - 1
+		reserve := 0
+		if remaining > 0 {
+			reserve = len(omittedLine(remaining))
+		}
+		line := digestLine(candidate.description, false) + "\n"
+		if builder.Len()+len(line)+reserve > budget {
+			line = digestLine(candidate.description, true) + "\n"
+		}
+		if builder.Len()+len(line)+reserve > budget {
+			digest.Omitted = len(candidates) - i
+			break
+		}
+		builder.WriteString(line)
+		digest.Tables++
+	}
+	if digest.Omitted > 0 {
+		if line := omittedLine(digest.Omitted); builder.Len()+len(line) <= budget {
+			builder.WriteString(line)
+		}
+	}
+	digest.Digest = builder.String()
+	digest.Tokens = (builder.Len() + charsPerToken - 1) / charsPerToken
+}
+
+func omittedLine(count int) string {
+	return "-- " + strconv.Itoa(count) + " more tables\n"
+}
+
+// digestLine renders table(column TYPE, id PK, ref_id FK→table.column); keysOnly keeps key
+// columns and counts the others.
+func digestLine(description *TableDescription, keysOnly bool) string {
+	markers := map[string][]string{}
+	for _, column := range description.PrimaryKey {
+		markers[column] = append(markers[column], "PK")
+	}
+	for _, fk := range description.ForeignKeys {
+		for i, column := range fk.Columns {
+			ref := fk.RefTable
+			if i < len(fk.RefColumns) {
+				ref += "." + fk.RefColumns[i]
+			}
+			markers[column] = append(markers[column], "FK→"+ref)
+		}
+	}
+	var items []string
+	skipped := 0
+	for _, column := range description.Columns {
+		item := column.Name
+		if marker, ok := markers[column.Name]; ok {
+			item += " " + strings.Join(marker, " ")
+		} else if keysOnly {
+			skipped++
+			continue
+		} else if columnType := shortType(column.Type); columnType != "" {
+			item += " " + columnType
+		}
+		items = append(items, item)
+	}
+	if skipped > 0 {
+		items = append(items, "+"+strconv.Itoa(skipped)+" cols")
+	}
+	return description.Table + "(" + strings.Join(items, ", ") + ")"
+}
+
+// shortTypes abbreviates verbose type names.
+var shortTypes = map[string]string{
+	"TIMESTAMP WITHOUT TIME ZONE": "TS",
+	"TIMESTAMP WITH TIME ZONE":    "TSTZ",
+	"TIMESTAMPTZ":                 "TSTZ",
+	"TIMESTAMP":                   "TS",
+	"DATETIME":                    "TS",
+	"DATETIME2":                   "TS",
+	"CHARACTER VARYING":           "VARCHAR",
+	"VARCHAR2":                    "VARCHAR",
+	"NVARCHAR2":                   "NVARCHAR",
+	"CHARACTER":                   "CHAR",
+	"INTEGER":                     "INT",
+	"BOOLEAN":                     "BOOL",
+	"DOUBLE PRECISION":            "DOUBLE",
+	"NUMERIC":                     "DECIMAL",
+}
+
+// shortType drops length/precision and abbreviates the column type.
+func shortType(columnType string) string {
+	columnType = strings.ToUpper(strings.TrimSpace(columnType))
+	if index := strings.Index(columnType, "("); index != -1 {
+		columnType = strings.TrimSpace(columnType[:index])
+	}
+	if short, ok := shortTypes[columnType]; ok {
+		return short
+	}
+	return columnType
+}
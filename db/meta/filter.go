@@ -0,0 +1,140 @@
+package meta
+
+import (
+	"path"
+	"strings"
+
+	"github.com/viant/sqlx/metadata/sink"
+)
+
+const (
+	// TableTypeTable selects base tables.
+	TableTypeTable = "table"
+	// TableTypeView selects views (including materialized views).
+	TableTypeView = "view"
+
+	defaultLimit = 200
+)
+
+// Page controls result paging shared by listing tools.
+type Page struct {
+	// Limit caps the number of returned entries (default 200, use -1 for all).
+	Limit int `json:"limit,omitempty"`
+
+	// Offset skips the given number of matching entries.
+	Offset int `json:"offset,omitempty"`
+}
+
+// PageInfo reports paging state of a listing result.
+type PageInfo struct {
+	Total      int `json:"total"`
+	Offset     int `json:"offset,omitempty"`
+	NextOffset int `json:"nextOffset,omitempty"`
+}
+
+// Entry is a compact metadata item (name, type, comment).
+type Entry struct {
+	Name    string `json:"name"`
+	Type    string `json:"type,omitempty"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// bounds returns the [start, end) slice range for total items and fills paging info.
+func (p *Page) bounds(total int, info *PageInfo) (int, int) {
+	info.Total = total
+	start := p.Offset
+	if start < 0 {
+		start = 0
+	}
+	if start > total {
+		start = total
+	}
+	limit := p.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	end := total
+	if limit > 0 && start+limit < total {
+		end = start + limit
+		info.NextOffset = end
+	}
+	info.Offset = start
+	return start, end
+}
+
+// matchName reports whether name matches a case-insensitive pattern. Both glob
+// (*, ?) and SQL LIKE (%, _) wildcards are supported; a pattern without
+// wildcards matches as a substring. Underscore is treated as a LIKE wildcard
+// only when the pattern also uses %, since it is common in identifiers.
+func matchName(pattern, name string) bool {
+	if pattern == "" {
+		return true
+	}
+	pattern = strings.ToLower(pattern)
+	name = strings.ToLower(name)
+	if !strings.ContainsAny(pattern, "*?%") {
+		return strings.Contains(name, pattern)
+	}
+	if strings.Contains(pattern, "%") {
+		pattern = strings.NewReplacer("%", "*", "_", "?").Replace(pattern)
+	}
+	pattern = strings.NewReplacer("[", "\\[", "]", "\\]").Replace(pattern)
+	ok, err := path.Match(pattern, name)
+	return err == nil && ok
+}
+
+// tableType normalizes driver specific table types to table or view.
+func tableType(table *sink.Table) string {
+	if table.Type != nil && strings.Contains(strings.ToUpper(*table.Type), "VIEW") {
+		return TableTypeView
+	}
+	return TableTypeTable
+}
+
+func filterTables(tables []sink.Table, pattern string, kind string) []sink.Table {
+	kind = strings.ToLower(kind)
+	var result []sink.Table
+	for i := range tables {
+		if !matchName(pattern, tables[i].Name) {
+			continue
+		}
+		if kind != "" && tableType(&tables[i]) != kind {
+			continue
+		}
+		result = append(result, tables[i])
+	}
+	return result
+}
+
+func filterColumns(columns []sink.Column, pattern string) []sink.Column {
+	if pattern == "" {
+		return columns
+	}
+	var result []sink.Column
+	for i := range columns {
+		if matchName(pattern, columns[i].Name) {
+			result = append(result, columns[i])
+		}
+	}
+	return result
+}
+
+func tableEntries(tables []sink.Table) []Entry {
+	result := make([]Entry, 0, len(tables))
+	for i := range tables {
+		entry := Entry{Name: tables[i].Name, Type: tableType(&tables[i])}
+		if tables[i].Comment != nil {
+			entry.Comment = *tables[i].Comment
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+func columnEntries(columns []sink.Column) []Entry {
+	result := make([]Entry, 0, len(columns))
+	for i := range columns {
+		result = append(result, Entry{Name: columns[i].Name, Type: columns[i].Type, Comment: columns[i].Comments})
+	}
+	return result
+}
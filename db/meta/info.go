@@ -0,0 +1,152 @@
+package meta
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/sqlx/metadata"
+	"github.com/viant/sqlx/metadata/product/ansi"
+)
+
+// InfoInput defines parameters for retrieving server and session information.
+type InfoInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+}
+
+// InfoOutput wraps server and session information.
+type InfoOutput struct {
+	Data      *DatabaseInfo `json:"data,omitempty"`
+	Status    string        `json:"status"`
+	Error     string        `json:"error,omitempty"`
+	Connector string        `json:"connector,omitempty"`
+}
+
+// DatabaseInfo describes the database product, version and the connector session.
+// Version is normalized as major.minor.release; ServerVersion is the raw server string.
+type DatabaseInfo struct {
+	Driver        string            `json:"driver"`
+	Product       string            `json:"product,omitempty"`
+	Version       string            `json:"version,omitempty"`
+	Major         int               `json:"major,omitempty"`
+	Minor         int               `json:"minor,omitempty"`
+	Release       int               `json:"release,omitempty"`
+	ServerVersion string            `json:"serverVersion,omitempty"`
+	User          string            `json:"user,omitempty"`
+	Catalog       string            `json:"catalog,omitempty"`
+	Schema        string            `json:"schema,omitempty"`
+	TimeZone      string            `json:"timeZone,omitempty"`
+	Charset       string            `json:"charset,omitempty"`
+	Settings      map[string]string `json:"settings,omitempty"`
+}
+
+// infoQueries return server version, current user, database/catalog, schema, time zone and character set.
+var infoQueries = map[string]string{
+	"mysql":    `SELECT VERSION(), CURRENT_USER(), '', COALESCE(DATABASE(), ''), IF(@@session.time_zone = 'SYSTEM', @@system_time_zone, @@session.time_zone), @@character_set_database`,
+	"postgres": `SELECT version(), current_user, current_database(), COALESCE(current_schema(), ''), current_setting('TimeZone'), pg_encoding_to_char(encoding) FROM pg_database WHERE datname = current_database()`,
+	"oracle":   `SELECT (SELECT VERSION FROM PRODUCT_COMPONENT_VERSION WHERE PRODUCT LIKE 'Oracle%' AND ROWNUM = 1), USER, SYS_CONTEXT('USERENV', 'DB_NAME'), SYS_CONTEXT('USERENV', 'CURRENT_SCHEMA'), SESSIONTIMEZONE, (SELECT VALUE FROM NLS_DATABASE_PARAMETERS WHERE PARAMETER = 'NLS_CHARACTERSET') FROM DUAL`,
+	"sqlite":   `SELECT sqlite_version(), '', '', 'main', '', (SELECT encoding FROM pragma_encoding)`,
+	"bigquery": `SELECT '', SESSION_USER(), '', '', @@time_zone, 'UTF-8'`,
+}
+
+// settingQueries return name and value of session settings that affect SQL semantics.
+var settingQueries = map[string]string{
+	"mysql": `SHOW SESSION VARIABLES WHERE Variable_name IN ('sql_mode', 'transaction_isolation', 'autocommit', 'collation_connection', 'character_set_client', 'lower_case_table_names', 'max_allowed_packet', 'version_comment')`,
+	"postgres": `SELECT name, setting FROM pg_settings WHERE name IN ('DateStyle', 'IntervalStyle', 'search_path', 'standard_conforming_strings', 'default_transaction_isolation', 'default_transaction_read_only',
+'statement_timeout', 'lock_timeout', 'client_encoding', 'lc_collate', 'max_connections')`,
+	"oracle": `SELECT PARAMETER, VALUE FROM NLS_SESSION_PARAMETERS`,
+	"sqlite": `SELECT 'foreign_keys', foreign_keys FROM pragma_foreign_keys
+UNION ALL SELECT 'journal_mode', journal_mode FROM pragma_journal_mode
+UNION ALL SELECT 'synchronous', synchronous FROM pragma_synchronous
+UNION ALL SELECT 'recursive_triggers', recursive_triggers FROM pragma_recursive_triggers`,
+}
+
+// Info returns database product and version, current user, database/schema, time zone, character set and session settings.
+func (s *Service) Info(ctx context.Context, input *InfoInput) *InfoOutput {
+	out := &InfoOutput{Status: "ok"}
+	if input == nil {
+		input = &InfoInput{}
+	}
+	if err := s.info(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) info(ctx context.Context, input *InfoInput, out *InfoOutput) error {
+	requestedConnector := input.Connector
+	conn, db, err := s.connection(ctx, requestedConnector)
+	if err != nil {
+		return err
+	}
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbInfo start connector=%q driver=%q", requestedConnector, conn.Driver)
+	info, err := s.loadInfo(ctx, conn, db)
+	if err != nil {
+		log.Printf("mcp-sqlkit dbInfo error connector=%q driver=%q elapsed=%s err=%v", requestedConnector, conn.Driver, time.Since(started), err)
+		return err
+	}
+	log.Printf("mcp-sqlkit dbInfo done connector=%q driver=%q product=%q version=%q elapsed=%s", requestedConnector, conn.Driver, info.Product, info.Version, time.Since(started))
+	out.Connector = requestedConnector
+	out.Data = info
+	return nil
+}
+
+func (s *Service) loadInfo(ctx context.Context, conn *connector.Connector, db *sql.DB) (*DatabaseInfo, error) {
+	info := &DatabaseInfo{Driver: conn.Driver}
+	// detection resolves the server version; the driver mapping covers drivers it cannot match
+	product, err := metadata.New().DetectProduct(ctx, db)
+	if err != nil || product == nil || product.Name == ansi.ANSI.Name {
+		product = metadataProduct(conn)
+	}
+	if product != nil {
+		info.Product, info.Major, info.Minor, info.Release = product.Name, product.Major, product.Minor, product.Release
+		if product.Major > 0 {
+			info.Version = fmt.Sprintf("%d.%d.%d", product.Major, product.Minor, product.Release)
+		}
+	}
+	driver := dialect.Of(conn.Driver).Driver
+	SQL, ok := infoQueries[driver]
+	if !ok {
+		if err := db.PingContext(ctx); err != nil {
+			return nil, err
+		}
+		return info, nil
+	}
+	if err := db.QueryRowContext(ctx, SQL).Scan(&info.ServerVersion, &info.User, &info.Catalog, &info.Schema, &info.TimeZone, &info.Charset); err != nil {
+		return nil, err
+	}
+	s.applyDefaults(ctx, conn, db, &info.Catalog, &info.Schema)
+	if SQL, ok = settingQueries[driver]; ok {
+		settings, err := querySettings(ctx, db, SQL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read session settings: %w", err)
+		}
+		info.Settings = settings
+	}
+	return info, nil
+}
+
+func querySettings(ctx context.Context, db *sql.DB, SQL string) (map[string]string, error) {
+	rows, err := db.QueryContext(ctx, SQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	settings := map[string]string{}
+	for rows.Next() {
+		var name string
+		var value sql.NullString
+		if err = rows.Scan(&name, &value); err != nil {
+			return nil, err
+		}
+		settings[name] = value.String
+	}
+	return settings, rows.Err()
+}
@@ -0,0 +1,105 @@
+package meta
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Markdown renders the table description: columns, keys, indexes, foreign keys and annotations.
+func (d *TableDescription) Markdown() string {
+	builder := &strings.Builder{}
+	builder.WriteString("# " + d.qualifiedName() + "\n\n")
+	writeTableMarkdown(builder, d, 1)
+	return builder.String()
+}
+
+func (d *TableDescription) qualifiedName() string {
+	if d.Schema != "" {
+		return d.Schema + "." + d.Table
+	}
+	return d.Table
+}
+
+// writeTableMarkdown renders the description body below a heading of the given level.
+func writeTableMarkdown(builder *strings.Builder, description *TableDescription, level int) {
+	section := strings.Repeat("#", level+1) + " "
+	if description.Annotation != nil {
+		builder.WriteString(annotationMarkdown(description.Annotation) + "\n")
+	}
+	builder.WriteString("| Column | Type | Nullable | Default | Comment |\n|---|---|---|---|---|\n")
+	for _, column := range description.Columns {
+		nullable := "NO"
+		if column.Nullable {
+			nullable = "YES"
+		}
+		builder.WriteString(fmt.Sprintf("| %v | %v | %v | %v | %v |\n", markdownCell(column.Name), markdownCell(column.Type), nullable, markdownCell(column.Default), markdownCell(column.Comment)))
+	}
+	if len(description.PrimaryKey) > 0 {
+		builder.WriteString("\nPrimary key: " + strings.Join(description.PrimaryKey, ", ") + "\n")
+	}
+	for _, unique := range description.Unique {
+		builder.WriteString("\nUnique: " + strings.Join(unique, ", ") + "\n")
+	}
+	if len(description.Indexes) > 0 {
+		builder.WriteString("\n" + section + "Indexes\n\n")
+		for _, index := range description.Indexes {
+			line := "- " + index.Name + " (" + strings.Join(index.Columns, ", ") + ")"
+			if index.Unique {
+				line += " unique"
+			}
+			builder.WriteString(line + "\n")
+		}
+	}
+	var annotated []*ColumnInfo
+	for _, column := range description.Columns {
+		if column.Annotation != nil {
+			annotated = append(annotated, column)
+		}
+	}
+	if len(annotated) > 0 {
+		builder.WriteString("\n" + section + "Column annotations\n")
+		for _, column := range annotated {
+			builder.WriteString("\n#" + section + column.Name + "\n\n" + annotationMarkdown(column.Annotation))
+		}
+	}
+	if len(description.ForeignKeys) > 0 {
+		builder.WriteString("\n" + section + "Foreign keys\n\n")
+		for _, fk := range description.ForeignKeys {
+			ref := fk.RefTable
+			if fk.RefSchema != "" {
+				ref = fk.RefSchema + "." + ref
+			}
+			builder.WriteString("- (" + strings.Join(fk.Columns, ", ") + ") -> " + ref + " (" + strings.Join(fk.RefColumns, ", ") + ")\n")
+		}
+	}
+}
+
+// annotationMarkdown renders description, unit, allowed values, PII flag, tags and examples.
+func annotationMarkdown(annotation *Annotation) string {
+	builder := &strings.Builder{}
+	if annotation.Description != "" {
+		builder.WriteString(annotation.Description + "\n\n")
+	}
+	if annotation.Unit != "" {
+		builder.WriteString("- Unit: " + annotation.Unit + "\n")
+	}
+	if len(annotation.Values) > 0 {
+		builder.WriteString("- Values: " + strings.Join(annotation.Values, ", ") + "\n")
+	}
+	if annotation.PII {
+		builder.WriteString("- PII: yes\n")
+	}
+	if len(annotation.Tags) > 0 {
+		builder.WriteString("- Tags: " + strings.Join(annotation.Tags, ", ") + "\n")
+	}
+	for _, example := range annotation.Examples {
+		builder.WriteString("- Example: `" + example + "`\n")
+	}
+	return strings.TrimSuffix(builder.String(), "\n") + "\n"
+}
+
+// markdownCell escapes pipes and line breaks in a Markdown table cell.
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.ReplaceAll(value, "\n", " ")
+}
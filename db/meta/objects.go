@@ -0,0 +1,394 @@
+package meta
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/sqlx/metadata"
+	"github.com/viant/sqlx/metadata/info"
+	"github.com/viant/sqlx/metadata/sink"
+)
+
+const (
+	// SequenceKindSequence marks sequence objects.
+	SequenceKindSequence = "sequence"
+	// SequenceKindAutoIncrement marks table auto-increment counters (MySQL, SQLite).
+	SequenceKindAutoIncrement = "auto_increment"
+)
+
+// ListObjectsInput defines parameters for listing views, routines or sequences.
+type ListObjectsInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Catalog/database name (optional).
+	Catalog string `json:"catalog,omitempty"`
+
+	// Schema name (optional – defaults to the connector schema).
+	Schema string `json:"schema,omitempty"`
+
+	// Pattern filters object names (substring, glob * ? or SQL LIKE %).
+	Pattern string `json:"pattern,omitempty"`
+
+	// Compact returns only name and type of each object.
+	Compact bool `json:"compact,omitempty"`
+
+	Page
+}
+
+// View describes a view with its definition.
+type View struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Definition string `json:"definition,omitempty"`
+}
+
+// Routine describes a stored procedure or function.
+type Routine struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Arguments string `json:"arguments,omitempty"`
+	Returns   string `json:"returns,omitempty"`
+	Language  string `json:"language,omitempty"`
+}
+
+// Sequence describes a sequence or auto-increment counter.
+type Sequence struct {
+	Name      string `json:"name"`
+	Kind      string `json:"kind"`
+	DataType  string `json:"dataType,omitempty"`
+	Value     *int64 `json:"value,omitempty"`
+	Increment int64  `json:"increment,omitempty"`
+	Start     int64  `json:"start,omitempty"`
+	Max       *int64 `json:"max,omitempty"`
+}
+
+// ViewsOutput wraps view metadata.
+type ViewsOutput struct {
+	Data      []*View   `json:"data,omitempty"`
+	Items     []Entry   `json:"items,omitempty"`
+	Page      *PageInfo `json:"page,omitempty"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Connector string    `json:"connector,omitempty"`
+}
+
+// RoutinesOutput wraps stored procedure and function metadata.
+type RoutinesOutput struct {
+	Data      []*Routine `json:"data,omitempty"`
+	Items     []Entry    `json:"items,omitempty"`
+	Page      *PageInfo  `json:"page,omitempty"`
+	Status    string     `json:"status"`
+	Error     string     `json:"error,omitempty"`
+	Connector string     `json:"connector,omitempty"`
+}
+
+// SequencesOutput wraps sequence metadata.
+type SequencesOutput struct {
+	Data      []*Sequence `json:"data,omitempty"`
+	Items     []Entry     `json:"items,omitempty"`
+	Page      *PageInfo   `json:"page,omitempty"`
+	Status    string      `json:"status"`
+	Error     string      `json:"error,omitempty"`
+	Connector string      `json:"connector,omitempty"`
+}
+
+// viewQueries return name, definition and type of views.
+var viewQueries = map[string]string{
+	"mysql": `SELECT TABLE_NAME, VIEW_DEFINITION, 'VIEW' FROM information_schema.VIEWS WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE())`,
+	"postgres": `SELECT c.relname, pg_get_viewdef(c.oid, true), CASE c.relkind WHEN 'm' THEN 'MATERIALIZED VIEW' ELSE 'VIEW' END
+FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('v', 'm') AND n.nspname = COALESCE(NULLIF($1, ''), current_schema())`,
+	"sqlite":   `SELECT name, sql, 'VIEW' FROM sqlite_master WHERE type = 'view'`,
+	"oracle":   `SELECT VIEW_NAME, TEXT_VC, 'VIEW' FROM ALL_VIEWS WHERE OWNER = NVL(:1, SYS_CONTEXT('USERENV','CURRENT_SCHEMA'))`,
+	"bigquery": "SELECT table_name, view_definition, 'VIEW' FROM $dataset.INFORMATION_SCHEMA.VIEWS",
+}
+
+// routineQueries return name, type, arguments, return type and language of routines.
+var routineQueries = map[string]string{
+	"mysql": `SELECT r.ROUTINE_NAME, r.ROUTINE_TYPE,
+(SELECT GROUP_CONCAT(CONCAT_WS(' ', p.PARAMETER_MODE, p.PARAMETER_NAME, p.DTD_IDENTIFIER) ORDER BY p.ORDINAL_POSITION SEPARATOR ', ')
+	FROM information_schema.PARAMETERS p WHERE p.SPECIFIC_SCHEMA = r.ROUTINE_SCHEMA AND p.SPECIFIC_NAME = r.SPECIFIC_NAME AND p.ORDINAL_POSITION > 0),
+r.DTD_IDENTIFIER, r.ROUTINE_BODY
+FROM information_schema.ROUTINES r WHERE r.ROUTINE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE())`,
+	"postgres": `SELECT p.proname, CASE p.prokind WHEN 'p' THEN 'PROCEDURE' WHEN 'a' THEN 'AGGREGATE' WHEN 'w' THEN 'WINDOW' ELSE 'FUNCTION' END,
+pg_get_function_arguments(p.oid), pg_get_function_result(p.oid), l.lanname
+FROM pg_proc p JOIN pg_namespace n ON n.oid = p.pronamespace JOIN pg_language l ON l.oid = p.prolang
+WHERE n.nspname = COALESCE(NULLIF($1, ''), current_schema())`,
+	"oracle": `SELECT o.OBJECT_NAME, o.OBJECT_TYPE,
+(SELECT LISTAGG(a.ARGUMENT_NAME || ' ' || a.IN_OUT || ' ' || a.DATA_TYPE, ', ') WITHIN GROUP (ORDER BY a.POSITION)
+	FROM ALL_ARGUMENTS a WHERE a.OWNER = o.OWNER AND a.OBJECT_NAME = o.OBJECT_NAME AND a.PACKAGE_NAME IS NULL AND a.POSITION > 0 AND a.DATA_LEVEL = 0),
+(SELECT MAX(a.DATA_TYPE) FROM ALL_ARGUMENTS a WHERE a.OWNER = o.OWNER AND a.OBJECT_NAME = o.OBJECT_NAME AND a.PACKAGE_NAME IS NULL AND a.POSITION = 0 AND a.DATA_LEVEL = 0),
+'PLSQL'
+FROM ALL_OBJECTS o WHERE o.OWNER = NVL(:1, SYS_CONTEXT('USERENV','CURRENT_SCHEMA')) AND o.OBJECT_TYPE IN ('FUNCTION', 'PROCEDURE', 'PACKAGE')`,
+	"bigquery": "SELECT r.routine_name, r.routine_type, " +
+		"(SELECT STRING_AGG(CONCAT(COALESCE(p.parameter_name, ''), ' ', p.data_type), ', ' ORDER BY p.ordinal_position) FROM $dataset.INFORMATION_SCHEMA.PARAMETERS p WHERE p.specific_name = r.specific_name AND p.ordinal_position > 0), " +
+		"r.data_type, COALESCE(r.external_language, r.routine_body) " +
+		"FROM $dataset.INFORMATION_SCHEMA.ROUTINES r",
+}
+
+// sequenceQueries return name, kind, data type, value, increment, start and max of sequences.
+var sequenceQueries = map[string]string{
+	"mysql": `SELECT TABLE_NAME, 'auto_increment', NULL, AUTO_INCREMENT, @@auto_increment_increment, @@auto_increment_offset, NULL
+FROM information_schema.TABLES WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND AUTO_INCREMENT IS NOT NULL`,
+	"postgres": `SELECT sequencename, 'sequence', data_type::text, last_value, increment_by, start_value, max_value
+FROM pg_sequences WHERE schemaname = COALESCE(NULLIF($1, ''), current_schema())`,
+}
+
+// ListViews returns views with their definitions.
+func (s *Service) ListViews(ctx context.Context, input *ListObjectsInput) *ViewsOutput {
+	out := &ViewsOutput{Status: "ok"}
+	if input == nil {
+		input = &ListObjectsInput{}
+	}
+	err := s.listObjects(ctx, "dbListViews", input, func(conn *connector.Connector, db *sql.DB) (int, error) {
+		views, err := s.views(ctx, conn, db, input.Catalog, input.Schema)
+		if err != nil {
+			return 0, err
+		}
+		views = filterObjects(views, input.Pattern, func(view *View) string { return view.Name })
+		out.Page = &PageInfo{}
+		start, end := input.Page.bounds(len(views), out.Page)
+		for _, view := range views[start:end] {
+			if input.Compact {
+				out.Items = append(out.Items, Entry{Name: view.Name, Type: view.Type})
+			} else {
+				out.Data = append(out.Data, view)
+			}
+		}
+		out.Connector = input.Connector
+		return len(views), nil
+	})
+	if err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+// ListRoutines returns stored procedures and functions with their signatures.
+func (s *Service) ListRoutines(ctx context.Context, input *ListObjectsInput) *RoutinesOutput {
+	out := &RoutinesOutput{Status: "ok"}
+	if input == nil {
+		input = &ListObjectsInput{}
+	}
+	err := s.listObjects(ctx, "dbListRoutines", input, func(conn *connector.Connector, db *sql.DB) (int, error) {
+		routines, err := s.routines(ctx, conn, db, input.Catalog, input.Schema)
+		if err != nil {
+			return 0, err
+		}
+		routines = filterObjects(routines, input.Pattern, func(routine *Routine) string { return routine.Name })
+		out.Page = &PageInfo{}
+		start, end := input.Page.bounds(len(routines), out.Page)
+		for _, routine := range routines[start:end] {
+			if input.Compact {
+				out.Items = append(out.Items, Entry{Name: routine.Name, Type: routine.Type})
+			} else {
+				out.Data = append(out.Data, routine)
+			}
+		}
+		out.Connector = input.Connector
+		return len(routines), nil
+	})
+	if err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+// ListSequences returns sequences and auto-increment counters.
+func (s *Service) ListSequences(ctx context.Context, input *ListObjectsInput) *SequencesOutput {
+	out := &SequencesOutput{Status: "ok"}
+	if input == nil {
+		input = &ListObjectsInput{}
+	}
+	err := s.listObjects(ctx, "dbListSequences", input, func(conn *connector.Connector, db *sql.DB) (int, error) {
+		sequences, err := s.sequences(ctx, conn, db, input.Catalog, input.Schema)
+		if err != nil {
+			return 0, err
+		}
+		sequences = filterObjects(sequences, input.Pattern, func(sequence *Sequence) string { return sequence.Name })
+		out.Page = &PageInfo{}
+		start, end := input.Page.bounds(len(sequences), out.Page)
+		for _, sequence := range sequences[start:end] {
+			if input.Compact {
+				out.Items = append(out.Items, Entry{Name: sequence.Name, Type: sequence.Kind})
+			} else {
+				out.Data = append(out.Data, sequence)
+			}
+		}
+		out.Connector = input.Connector
+		return len(sequences), nil
+	})
+	if err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+// listObjects resolves connection and defaults, and logs the listing of a schema object kind.
+func (s *Service) listObjects(ctx context.Context, tool string, input *ListObjectsInput, list func(conn *connector.Connector, db *sql.DB) (int, error)) error {
+	conn, db, err := s.connection(ctx, input.Connector)
+	if err != nil {
+		return err
+	}
+	s.applyDefaults(ctx, conn, db, &input.Catalog, &input.Schema)
+	started := time.Now()
+	log.Printf("mcp-sqlkit %v start connector=%q driver=%q catalog=%q schema=%q", tool, input.Connector, conn.Driver, input.Catalog, input.Schema)
+	count, err := list(conn, db)
+	if err != nil {
+		log.Printf("mcp-sqlkit %v error connector=%q driver=%q elapsed=%s err=%v", tool, input.Connector, conn.Driver, time.Since(started), err)
+		return err
+	}
+	log.Printf("mcp-sqlkit %v done connector=%q driver=%q count=%d elapsed=%s", tool, input.Connector, conn.Driver, count, time.Since(started))
+	return nil
+}
+
+func (s *Service) views(ctx context.Context, conn *connector.Connector, db *sql.DB, catalog, schema string) ([]*View, error) {
+	d := dialect.Of(conn.Driver)
+	SQL, args, ok := objectQuery(viewQueries, d, catalog, schema)
+	if !ok {
+		return nil, fmt.Errorf("listing views is not supported for driver %v", conn.Driver)
+	}
+	var result []*View
+	err := scanObjects(ctx, db, SQL, args, func(values []sql.NullString) {
+		result = append(result, &View{Name: values[0].String, Definition: strings.TrimSpace(values[1].String), Type: values[2].String})
+	}, 3)
+	return result, err
+}
+
+func (s *Service) routines(ctx context.Context, conn *connector.Connector, db *sql.DB, catalog, schema string) ([]*Routine, error) {
+	d := dialect.Of(conn.Driver)
+	if d.Is("sqlite") {
+		// SQLite has no stored routines; list functions registered by extensions or the application.
+		var functions []sink.Function
+		if err := metadata.New().Info(ctx, db, info.KindFunctions, &functions, s.metadataOptions(conn, catalog, schema)...); err != nil {
+			return nil, err
+		}
+		var result []*Routine
+		for _, function := range functions {
+			if function.Type != "NATIVE" {
+				result = append(result, &Routine{Name: function.Name, Type: "FUNCTION"})
+			}
+		}
+		return result, nil
+	}
+	SQL, args, ok := objectQuery(routineQueries, d, catalog, schema)
+	if !ok {
+		return nil, fmt.Errorf("listing routines is not supported for driver %v", conn.Driver)
+	}
+	var result []*Routine
+	err := scanObjects(ctx, db, SQL, args, func(values []sql.NullString) {
+		result = append(result, &Routine{Name: values[0].String, Type: values[1].String, Arguments: values[2].String, Returns: values[3].String, Language: values[4].String})
+	}, 5)
+	return result, err
+}
+
+func (s *Service) sequences(ctx context.Context, conn *connector.Connector, db *sql.DB, catalog, schema string) ([]*Sequence, error) {
+	d := dialect.Of(conn.Driver)
+	switch d.Driver {
+	case "bigquery":
+		return nil, nil // BigQuery has no sequences
+	case "sqlite", "oracle":
+		var sequences []sink.Sequence
+		if err := metadata.New().Info(ctx, db, info.KindSequences, &sequences, s.metadataOptions(conn, catalog, schema)...); err != nil {
+			if d.Is("sqlite") && strings.Contains(err.Error(), "no such table") {
+				return nil, nil // sqlite_sequence exists only once an AUTOINCREMENT table was created
+			}
+			return nil, err
+		}
+		kind := SequenceKindSequence
+		if d.Is("sqlite") {
+			kind = SequenceKindAutoIncrement
+		}
+		var result []*Sequence
+		for i := range sequences {
+			sequence := &sequences[i]
+			result = append(result, &Sequence{Name: sequence.Name, Kind: kind, DataType: sequence.DataType, Value: &sequence.Value, Increment: sequence.IncrementBy, Start: sequence.StartValue, Max: &sequence.MaxValue})
+		}
+		return result, nil
+	}
+	SQL, args, ok := objectQuery(sequenceQueries, d, catalog, schema)
+	if !ok {
+		return nil, fmt.Errorf("listing sequences is not supported for driver %v", conn.Driver)
+	}
+	rows, err := db.QueryContext(ctx, SQL, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*Sequence
+	for rows.Next() {
+		var name, kind string
+		var dataType sql.NullString
+		var value, increment, start, maxValue sql.NullInt64
+		if err = rows.Scan(&name, &kind, &dataType, &value, &increment, &start, &maxValue); err != nil {
+			return nil, err
+		}
+		sequence := &Sequence{Name: name, Kind: kind, DataType: dataType.String, Increment: increment.Int64, Start: start.Int64}
+		if value.Valid {
+			sequence.Value = &value.Int64
+		}
+		if maxValue.Valid {
+			sequence.Max = &maxValue.Int64
+		}
+		result = append(result, sequence)
+	}
+	return result, rows.Err()
+}
+
+// objectQuery returns a dialect query with its arguments; BigQuery queries are qualified with the dataset.
+func objectQuery(queries map[string]string, d *dialect.Dialect, catalog, schema string) (string, []interface{}, bool) {
+	SQL, ok := queries[d.Driver]
+	if !ok {
+		return "", nil, false
+	}
+	switch d.Driver {
+	case "sqlite":
+		return SQL, nil, true
+	case "bigquery":
+		return strings.ReplaceAll(SQL, "$dataset", "`"+qualified(catalog, schema)+"`"), nil, true
+	}
+	return SQL, []interface{}{nullable(schema)}, true
+}
+
+func scanObjects(ctx context.Context, db *sql.DB, SQL string, args []interface{}, add func(values []sql.NullString), columns int) error {
+	rows, err := db.QueryContext(ctx, SQL, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	values := make([]sql.NullString, columns)
+	pointers := make([]interface{}, columns)
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err = rows.Scan(pointers...); err != nil {
+			return err
+		}
+		add(values)
+	}
+	return rows.Err()
+}
+
+// filterObjects returns objects matching pattern sorted by name.
+func filterObjects[T any](objects []T, pattern string, name func(T) string) []T {
+	var result []T
+	for _, object := range objects {
+		if matchName(pattern, name(object)) {
+			result = append(result, object)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return name(result[i]) < name(result[j]) })
+	return result
+}
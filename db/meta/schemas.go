@@ -0,0 +1,173 @@
+package meta
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/mcp-sqlkit/db/dsn"
+	"github.com/viant/sqlx/metadata"
+	"github.com/viant/sqlx/metadata/info"
+	"github.com/viant/sqlx/metadata/sink"
+)
+
+// ListSchemasInput defines parameters for retrieving schemas reachable by a connector.
+type ListSchemasInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Catalog/database name (optional – defaults to the connector catalog, e.g. BigQuery project).
+	Catalog string `json:"catalog,omitempty"`
+
+	// Pattern filters schema names (substring, glob * ? or SQL LIKE %).
+	Pattern string `json:"pattern,omitempty"`
+
+	Page
+}
+
+// SchemaDefaults are the catalog and schema unqualified names resolve in.
+type SchemaDefaults struct {
+	Catalog string `json:"catalog,omitempty"`
+	Schema  string `json:"schema,omitempty"`
+}
+
+// SchemasOutput wraps schema metadata.
+type SchemasOutput struct {
+	Data      []sink.Schema   `json:"data,omitempty"`
+	Catalogs  []string        `json:"catalogs,omitempty"`
+	Default   *SchemaDefaults `json:"default,omitempty"`
+	Page      *PageInfo       `json:"page,omitempty"`
+	Status    string          `json:"status"`
+	Error     string          `json:"error,omitempty"`
+	Connector string          `json:"connector,omitempty"`
+}
+
+func bigQuerySchemas(ctx context.Context, db *sql.DB, project string) ([]sink.Schema, error) {
+	source := "INFORMATION_SCHEMA.SCHEMATA"
+	if project != "" {
+		source = "`" + project + "`." + source
+	}
+	rows, err := db.QueryContext(ctx, "SELECT CATALOG_NAME, SCHEMA_NAME, COALESCE(LOCATION, '') FROM "+source+" ORDER BY SCHEMA_NAME")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []sink.Schema
+	for rows.Next() {
+		schema := sink.Schema{}
+		if err = rows.Scan(&schema.Catalog, &schema.Name, &schema.Region); err != nil {
+			return nil, err
+		}
+		schema.Path = schema.Region
+		result = append(result, schema)
+	}
+	return result, rows.Err()
+}
+
+// catalogRow is a KindCatalogs metadata row.
+type catalogRow struct {
+	Name string `sqlx:"CATALOG_NAME"`
+}
+
+// catalogsSQL lists catalogs for engines where sqlx has no catalog metadata.
+var catalogsSQL = map[string]string{
+	"postgres": "SELECT datname AS CATALOG_NAME FROM pg_database WHERE NOT datistemplate ORDER BY datname",
+}
+
+// ListSchemas returns schemas (and catalogs where the engine has them) reachable by the connector.
+func (s *Service) ListSchemas(ctx context.Context, input *ListSchemasInput) *SchemasOutput {
+	out := &SchemasOutput{Status: "ok"}
+	if input == nil {
+		input = &ListSchemasInput{}
+	}
+	if err := s.listSchemas(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) listSchemas(ctx context.Context, input *ListSchemasInput, out *SchemasOutput) error {
+	requestedConnector := input.Connector
+	conn, db, err := s.connection(ctx, requestedConnector)
+	if err != nil {
+		return err
+	}
+	location := s.defaultLocation(ctx, conn, db)
+	catalog := input.Catalog
+	if catalog == "" {
+		catalog = location.Catalog
+	}
+	m := metadata.New()
+	var schemas []sink.Schema
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbListSchemas start connector=%q driver=%q catalog=%q", requestedConnector, conn.Driver, catalog)
+	if dialect.Of(conn.Driver).Is("bigquery") {
+		// sqlx BigQuery schemas query expects a project qualifier it cannot receive as criteria
+		schemas, err = bigQuerySchemas(ctx, db, catalog)
+	} else {
+		err = m.Info(ctx, db, info.KindSchemas, &schemas, s.metadataOptions(conn, catalog)...)
+	}
+	if err != nil {
+		log.Printf("mcp-sqlkit dbListSchemas error connector=%q driver=%q catalog=%q elapsed=%s err=%v", requestedConnector, conn.Driver, catalog, time.Since(started), err)
+		return err
+	}
+	log.Printf("mcp-sqlkit dbListSchemas done connector=%q driver=%q catalog=%q schemas=%d elapsed=%s", requestedConnector, conn.Driver, catalog, len(schemas), time.Since(started))
+
+	out.Connector = requestedConnector
+	out.Default = &SchemaDefaults{Catalog: location.Catalog, Schema: location.Schema}
+	out.Catalogs = s.catalogs(ctx, db, conn, schemas, location)
+	var filtered []sink.Schema
+	for i := range schemas {
+		if matchName(input.Pattern, schemas[i].Name) {
+			filtered = append(filtered, schemas[i])
+		}
+	}
+	out.Page = &PageInfo{}
+	start, end := input.Page.bounds(len(filtered), out.Page)
+	out.Data = filtered[start:end]
+	return nil
+}
+
+// catalogs lists catalogs using engine specific queries, falling back to catalogs
+// referenced by schemas and the connector default.
+func (s *Service) catalogs(ctx context.Context, db *sql.DB, conn *connector.Connector, schemas []sink.Schema, location *dsn.Info) []string {
+	unique := map[string]bool{}
+	var catalogs []catalogRow
+	if err := metadata.New().Info(ctx, db, info.KindCatalogs, &catalogs, s.metadataOptions(conn)...); err == nil {
+		for _, item := range catalogs {
+			if item.Name != "" {
+				unique[item.Name] = true
+			}
+		}
+	}
+	if SQL, ok := catalogsSQL[dialect.Of(conn.Driver).Driver]; ok {
+		if rows, err := db.QueryContext(ctx, SQL); err == nil {
+			defer rows.Close()
+			for rows.Next() {
+				var name string
+				if rows.Scan(&name) == nil && name != "" {
+					unique[name] = true
+				}
+			}
+		}
+	}
+	for i := range schemas {
+		if schemas[i].Catalog != "" {
+			unique[schemas[i].Catalog] = true
+		}
+	}
+	if location.Catalog != "" {
+		unique[location.Catalog] = true
+	}
+	var result []string
+	for name := range unique {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
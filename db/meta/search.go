@@ -0,0 +1,400 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+)
+
+const (
+	// SearchModeGlob matches substrings or glob/LIKE patterns.
+	SearchModeGlob = "glob"
+	// SearchModeRegex matches case-insensitive regular expressions.
+	SearchModeRegex = "regex"
+	// SearchModeFuzzy matches names by edit distance ignoring case and separators.
+	SearchModeFuzzy = "fuzzy"
+
+	// SearchScopeTable restricts search to table names and comments.
+	SearchScopeTable = "table"
+	// SearchScopeColumn restricts search to column names and comments.
+	SearchScopeColumn = "column"
+
+	defaultSearchLimit = 50
+	searchWorkers      = 4
+	// maxSearchTables caps per-connector column scans – each table needs its own catalog query.
+	maxSearchTables = 500
+	fuzzyThreshold  = 0.6
+	commentWeight   = 0.6
+)
+
+// SearchSchemaInput defines parameters for searching schema objects across connectors.
+type SearchSchemaInput struct {
+	// Query to search for in table and column names and comments.
+	Query string `json:"query"`
+
+	// Mode of matching (default glob: substring, * ? or SQL LIKE %).
+	Mode string `json:"mode,omitempty" choice:"glob" choice:"regex" choice:"fuzzy"`
+
+	// Scope limits search to tables or columns (default both).
+	Scope string `json:"scope,omitempty" choice:"table" choice:"column"`
+
+	// Connectors limits search to the listed connectors (default all in the namespace).
+	Connectors []string `json:"connectors,omitempty"`
+
+	// Schema name (optional – defaults to each connector schema).
+	Schema string `json:"schema,omitempty"`
+
+	// Limit caps the number of returned matches (default 50, use -1 for all).
+	Limit int `json:"limit,omitempty"`
+
+	// Refresh bypasses the metadata cache and reloads it from the database catalogs.
+	Refresh bool `json:"refresh,omitempty"`
+}
+
+// SearchSchemaOutput wraps ranked schema matches.
+type SearchSchemaOutput struct {
+	Data       []*SearchMatch    `json:"data,omitempty"`
+	Total      int               `json:"total"`
+	Connectors []string          `json:"connectors,omitempty"`
+	Errors     []*ConnectorError `json:"errors,omitempty"`
+	Status     string            `json:"status"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// SearchMatch is a table or column matching the search query.
+type SearchMatch struct {
+	Connector string  `json:"connector"`
+	Schema    string  `json:"schema,omitempty"`
+	Table     string  `json:"table"`
+	Column    string  `json:"column,omitempty"`
+	Type      string  `json:"type,omitempty"`
+	Match     string  `json:"match"`
+	Comment   string  `json:"comment,omitempty"`
+	Score     float64 `json:"score"`
+}
+
+// ConnectorError reports a connector, or a table when set, that could not be fully searched.
+type ConnectorError struct {
+	Connector string `json:"connector"`
+	Table     string `json:"table,omitempty"`
+	Error     string `json:"error"`
+}
+
+// SearchSchema searches table and column names and comments across connectors visible in the caller's namespace.
+func (s *Service) SearchSchema(ctx context.Context, input *SearchSchemaInput) *SearchSchemaOutput {
+	out := &SearchSchemaOutput{Status: "ok"}
+	if input == nil {
+		input = &SearchSchemaInput{}
+	}
+	if err := s.searchSchema(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) searchSchema(ctx context.Context, input *SearchSchemaInput, out *SearchSchemaOutput) error {
+	if strings.TrimSpace(input.Query) == "" {
+		return fmt.Errorf("query was empty")
+	}
+	scorer, err := newMatcher(input.Mode, input.Query)
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(input.Scope) {
+	case "", SearchScopeTable, SearchScopeColumn:
+	default:
+		return fmt.Errorf("unsupported search scope: %v", input.Scope)
+	}
+	connectors, missing := s.searchConnectors(ctx, input.Connectors)
+	for _, name := range missing {
+		out.Errors = append(out.Errors, &ConnectorError{Connector: name, Error: "connector not found"})
+	}
+	if len(connectors) == 0 {
+		return fmt.Errorf("no connectors available to search")
+	}
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbSearchSchema start query=%q mode=%q connectors=%d", input.Query, input.Mode, len(connectors))
+
+	var mux sync.Mutex
+	var matches []*SearchMatch
+	queue := make(chan *connector.Connector)
+	wg := sync.WaitGroup{}
+	for i := 0; i < min(searchWorkers, len(connectors)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for conn := range queue {
+				found, errs := s.searchConnector(ctx, conn, input, scorer)
+				mux.Lock()
+				matches = append(matches, found...)
+				out.Errors = append(out.Errors, errs...)
+				mux.Unlock()
+			}
+		}()
+	}
+	for _, conn := range connectors {
+		out.Connectors = append(out.Connectors, conn.Name)
+		queue <- conn
+	}
+	close(queue)
+	wg.Wait()
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		left, right := matches[i], matches[j]
+		return left.Connector+"."+left.Table+"."+left.Column < right.Connector+"."+right.Table+"."+right.Column
+	})
+	sort.Slice(out.Errors, func(i, j int) bool {
+		if out.Errors[i].Connector != out.Errors[j].Connector {
+			return out.Errors[i].Connector < out.Errors[j].Connector
+		}
+		return out.Errors[i].Table < out.Errors[j].Table
+	})
+	out.Total = len(matches)
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	out.Data = matches
+	log.Printf("mcp-sqlkit dbSearchSchema done query=%q connectors=%d matches=%d errors=%d elapsed=%s", input.Query, len(connectors), out.Total, len(out.Errors), time.Since(started))
+	failed := 0
+	for _, connectorError := range out.Errors {
+		if connectorError.Table == "" {
+			failed++
+		}
+	}
+	if failed >= len(connectors)+len(missing) && out.Total == 0 {
+		return fmt.Errorf("failed to search connectors: %v", out.Errors[0].Error)
+	}
+	return nil
+}
+
+// searchConnectors resolves connectors to search, sorted by name, and reports unknown requested names.
+func (s *Service) searchConnectors(ctx context.Context, names []string) ([]*connector.Connector, []string) {
+	var result []*connector.Connector
+	var missing []string
+	available := s.connectors.List(ctx)
+	if len(names) == 0 {
+		result = available
+	} else {
+		byName := map[string]*connector.Connector{}
+		for _, conn := range available {
+			byName[conn.Name] = conn
+		}
+		for _, name := range names {
+			if conn, ok := byName[name]; ok {
+				result = append(result, conn)
+			} else {
+				missing = append(missing, name)
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, missing
+}
+
+func (s *Service) searchConnector(ctx context.Context, conn *connector.Connector, input *SearchSchemaInput, scorer *matcher) ([]*SearchMatch, []*ConnectorError) {
+	failed := func(err error) []*ConnectorError {
+		return []*ConnectorError{{Connector: conn.Name, Error: err.Error()}}
+	}
+	db, err := conn.Db(ctx)
+	if err != nil {
+		return nil, failed(err)
+	}
+	catalog, schema := "", input.Schema
+	s.applyDefaults(ctx, conn, db, &catalog, &schema)
+	tables, _, err := s.tables(ctx, conn, db, catalog, schema, input.Refresh)
+	if err != nil {
+		return nil, failed(err)
+	}
+	scope := strings.ToLower(input.Scope)
+	var result []*SearchMatch
+	if scope != SearchScopeColumn {
+		for i := range tables {
+			table := &tables[i]
+			comment := ""
+			if table.Comment != nil {
+				comment = *table.Comment
+			}
+			if score, match := scorer.scoreObject(table.Name, "", comment); score > 0 {
+				result = append(result, &SearchMatch{Connector: conn.Name, Schema: schema, Table: table.Name, Type: tableType(table), Match: match, Comment: comment, Score: score})
+			}
+		}
+	}
+	if scope == SearchScopeTable {
+		return result, nil
+	}
+	if len(tables) > maxSearchTables {
+		return result, failed(fmt.Errorf("column search skipped: %d tables exceed %d, narrow with schema", len(tables), maxSearchTables))
+	}
+	var errs []*ConnectorError
+	for i := range tables {
+		columns, _, err := s.columns(ctx, conn, db, catalog, schema, tables[i].Name, input.Refresh)
+		if err != nil {
+			// a table that cannot be read must not hide matches in the remaining tables
+			errs = append(errs, &ConnectorError{Connector: conn.Name, Table: tables[i].Name, Error: err.Error()})
+			continue
+		}
+		for j := range columns {
+			column := &columns[j]
+			if score, match := scorer.scoreObject(column.Name, tables[i].Name, column.Comments); score > 0 {
+				result = append(result, &SearchMatch{Connector: conn.Name, Schema: schema, Table: tables[i].Name, Column: column.Name, Type: column.Type, Match: match, Comment: column.Comments, Score: score})
+			}
+		}
+	}
+	return result, errs
+}
+
+// matcher scores names and comments against a search query in [0, 1].
+type matcher struct {
+	mode       string
+	query      string
+	normalized string
+	expr       *regexp.Regexp
+}
+
+func newMatcher(mode, query string) (*matcher, error) {
+	ret := &matcher{mode: strings.ToLower(mode), query: strings.ToLower(strings.TrimSpace(query))}
+	switch ret.mode {
+	case "":
+		ret.mode = SearchModeGlob
+	case SearchModeGlob, SearchModeFuzzy:
+	case SearchModeRegex:
+		expr, err := regexp.Compile("(?i)" + ret.query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+		ret.expr = expr
+	default:
+		return nil, fmt.Errorf("unsupported search mode: %v", mode)
+	}
+	ret.normalized = normalizeName(ret.query)
+	return ret, nil
+}
+
+// scoreObject scores object name (optionally qualified by its table in fuzzy
+// mode) and comment; it returns the best score and what matched.
+func (m *matcher) scoreObject(name, table, comment string) (float64, string) {
+	score := m.score(name)
+	if table != "" && m.mode == SearchModeFuzzy && strings.Contains(m.normalized, normalizeName(name)) {
+		// e.g. "customer email" matching customers.email
+		score = max(score, 0.95*m.score(table+name))
+	}
+	if score > 0 {
+		return score, "name"
+	}
+	if comment == "" {
+		return 0, ""
+	}
+	if score = m.scoreComment(comment); score > 0 {
+		return score, "comment"
+	}
+	return 0, ""
+}
+
+func (m *matcher) score(name string) float64 {
+	name = strings.ToLower(name)
+	switch m.mode {
+	case SearchModeRegex:
+		found := m.expr.FindString(name)
+		if found == "" && !m.expr.MatchString(name) {
+			return 0
+		}
+		return containsScore(len(found), len(name))
+	case SearchModeFuzzy:
+		normalized := normalizeName(name)
+		if normalized == "" || m.normalized == "" {
+			return 0
+		}
+		if strings.Contains(normalized, m.normalized) {
+			return containsScore(len(m.normalized), len(normalized))
+		}
+		similarity := 1 - float64(levenshtein(m.normalized, normalized))/float64(max(len(m.normalized), len(normalized)))
+		if similarity < fuzzyThreshold {
+			return 0
+		}
+		return 0.8 * similarity
+	}
+	if strings.ContainsAny(m.query, "*?%") {
+		if matchName(m.query, name) {
+			return 0.7
+		}
+		return 0
+	}
+	if !strings.Contains(name, m.query) {
+		return 0
+	}
+	return containsScore(len(m.query), len(name))
+}
+
+func (m *matcher) scoreComment(comment string) float64 {
+	comment = strings.ToLower(comment)
+	switch m.mode {
+	case SearchModeRegex:
+		if m.expr.MatchString(comment) {
+			return commentWeight * 0.5
+		}
+	case SearchModeFuzzy:
+		if strings.Contains(normalizeName(comment), m.normalized) {
+			return commentWeight * 0.5
+		}
+	default:
+		if matchName(m.query, comment) || (strings.ContainsAny(m.query, "*?%") && matchName("*"+m.query+"*", comment)) {
+			return commentWeight * 0.5
+		}
+	}
+	return 0
+}
+
+// containsScore ranks exact matches first, then by the matched share of the name.
+func containsScore(matched, total int) float64 {
+	if total == 0 || matched >= total {
+		return 1
+	}
+	return 0.5 + 0.4*float64(matched)/float64(total)
+}
+
+// normalizeName lower-cases name and drops separators so that customer_email,
+// CustomerEmail and "customer email" compare equal.
+func normalizeName(name string) string {
+	builder := strings.Builder{}
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -4,18 +4,23 @@ import (
 	"context"
 	"database/sql"
 	"log"
-	"net/url"
-	"strings"
 	"time"
 
+	"github.com/viant/afs"
 	// Ensure popular drivers & metadata products are registered.
 	_ "github.com/viant/mcp-sqlkit/db/driver"
 
 	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/mcp-sqlkit/db/dsn"
 	"github.com/viant/sqlx/metadata"
 	"github.com/viant/sqlx/metadata/database"
 	"github.com/viant/sqlx/metadata/info"
 	bigqueryproduct "github.com/viant/sqlx/metadata/product/bigquery"
+	mysqlproduct "github.com/viant/sqlx/metadata/product/mysql"
+	oracleproduct "github.com/viant/sqlx/metadata/product/oracle"
+	pgproduct "github.com/viant/sqlx/metadata/product/pg"
+	sqliteproduct "github.com/viant/sqlx/metadata/product/sqlite"
 	"github.com/viant/sqlx/metadata/sink"
 	"github.com/viant/sqlx/option"
 )
@@ -30,6 +35,20 @@ type ListTablesInput struct {
 
 	// Schema name (optional – defaults depend on the driver).
 	Schema string `json:"schema,omitempty"`
+
+	// Pattern filters table names (substring, glob * ? or SQL LIKE %).
+	Pattern string `json:"pattern,omitempty"`
+
+	// Type filters by table type.
+	Type string `json:"type,omitempty" choice:"table" choice:"view"`
+
+	// Compact returns only name, type and comment for each table.
+	Compact bool `json:"compact,omitempty"`
+
+	// Refresh bypasses the metadata cache and reloads it from the database catalog.
+	Refresh bool `json:"refresh,omitempty"`
+
+	Page
 }
 
 // ListColumnsInput defines parameters for retrieving column metadata of a table.
@@ -45,6 +64,17 @@ type ListColumnsInput struct {
 
 	// Table for which columns should be listed.
 	Table string `json:"table"`
+
+	// Pattern filters column names (substring, glob * ? or SQL LIKE %).
+	Pattern string `json:"pattern,omitempty"`
+
+	// Compact returns only name, type and comment for each column.
+	Compact bool `json:"compact,omitempty"`
+
+	// Refresh bypasses the metadata cache and reloads it from the database catalog.
+	Refresh bool `json:"refresh,omitempty"`
+
+	Page
 }
 
 // Output follows the same envelope format used by other DB tools.
@@ -52,6 +82,9 @@ type ListColumnsInput struct {
 // TablesOutput wraps table metadata.
 type TablesOutput struct {
 	Data      []sink.Table `json:"data,omitempty"`
+	Items     []Entry      `json:"items,omitempty"`
+	Page      *PageInfo    `json:"page,omitempty"`
+	Cached    bool         `json:"cached,omitempty"`
 	Status    string       `json:"status"`
 	Error     string       `json:"error,omitempty"`
 	Connector string       `json:"connector,omitempty"`
@@ -59,20 +92,31 @@ type TablesOutput struct {
 
 // ColumnsOutput wraps column metadata.
 type ColumnsOutput struct {
-	Data      []sink.Column `json:"data,omitempty"`
-	Status    string        `json:"status"`
-	Error     string        `json:"error,omitempty"`
-	Connector string        `json:"connector,omitempty"`
+	Data        []sink.Column          `json:"data,omitempty"`
+	Items       []Entry                `json:"items,omitempty"`
+	Annotations map[string]*Annotation `json:"annotations,omitempty"`
+	Page        *PageInfo              `json:"page,omitempty"`
+	Cached      bool                   `json:"cached,omitempty"`
+	Status      string                 `json:"status"`
+	Error       string                 `json:"error,omitempty"`
+	Connector   string                 `json:"connector,omitempty"`
 }
 
 // Service provides metadata listing capabilities.
 type Service struct {
-	connectors *connector.Service
+	connectors  *connector.Service
+	cache       *Cache
+	annotations *AnnotationStore
+	fs          afs.Service
 }
 
 // New creates a metadata service instance.
-func New(connectors *connector.Service) *Service {
-	return &Service{connectors: connectors}
+func New(connectors *connector.Service, options ...Option) *Service {
+	ret := &Service{connectors: connectors, fs: afs.New()}
+	for _, opt := range options {
+		opt(ret)
+	}
+	return ret
 }
 
 // ListTables returns tables available in the specified catalog/schema.
@@ -109,32 +153,67 @@ func (s *Service) listTables(ctx context.Context, input *ListTablesInput, out *T
 		return err
 	}
 
-	if input.Schema == "" {
-		input.Schema = extractSchema(conn)
-	}
-	m := metadata.New()
-	var tables []sink.Table
+	s.applyDefaults(ctx, conn, db, &input.Catalog, &input.Schema)
 	started := time.Now()
-	log.Printf("mcp-sqlkit dbListTables start connector=%q driver=%q catalog=%q schema=%q", requestedConnector, conn.Driver, input.Catalog, input.Schema)
-	if err := m.Info(ctx, db, info.KindTables, &tables, s.metadataOptions(conn, input.Catalog, input.Schema)...); err != nil {
+	log.Printf("mcp-sqlkit dbListTables start connector=%q driver=%q catalog=%q schema=%q refresh=%v", requestedConnector, conn.Driver, input.Catalog, input.Schema, input.Refresh)
+	tables, hit, err := s.tables(ctx, conn, db, input.Catalog, input.Schema, input.Refresh)
+	if err != nil {
 		log.Printf("mcp-sqlkit dbListTables error connector=%q driver=%q catalog=%q schema=%q elapsed=%s err=%v", requestedConnector, conn.Driver, input.Catalog, input.Schema, time.Since(started), err)
 		return err
 	}
-	log.Printf("mcp-sqlkit dbListTables done connector=%q driver=%q catalog=%q schema=%q tables=%d elapsed=%s", requestedConnector, conn.Driver, input.Catalog, input.Schema, len(tables), time.Since(started))
+	log.Printf("mcp-sqlkit dbListTables done connector=%q driver=%q catalog=%q schema=%q tables=%d cached=%v elapsed=%s", requestedConnector, conn.Driver, input.Catalog, input.Schema, len(tables), hit, time.Since(started))
 
 	out.Connector = requestedConnector
+	out.Cached = hit
+	tables = filterTables(tables, input.Pattern, input.Type)
+	out.Page = &PageInfo{}
+	start, end := input.Page.bounds(len(tables), out.Page)
+	tables = tables[start:end]
+	if input.Compact {
+		out.Items = tableEntries(tables)
+		return nil
+	}
 	out.Data = tables
 	return nil
 }
 
-func extractSchema(conn *connector.Connector) string {
-	if conn == nil {
-		return ""
+// currentSchemaQueries read the session schema of drivers whose DSN may not name it,
+// e.g. Oracle connectors with templated ($Username) credentials.
+var currentSchemaQueries = map[string]string{
+	"oracle": "SELECT SYS_CONTEXT('USERENV', 'CURRENT_SCHEMA') FROM DUAL",
+}
+
+// defaultLocation returns connection coordinates derived from the connector DSN;
+// a schema the DSN does not name is read from the session when the driver supports it.
+func (s *Service) defaultLocation(ctx context.Context, conn *connector.Connector, db *sql.DB) *dsn.Info {
+	location := dsn.Parse(conn.Driver, conn.DSN)
+	SQL, ok := currentSchemaQueries[dialect.Of(conn.Driver).Driver]
+	if location.Schema != "" || !ok || db == nil {
+		return location
 	}
-	if URL, err := url.Parse(conn.DSN); err == nil {
-		return strings.Trim(URL.Path, "/")
+	schema, _, err := cached(ctx, s, conn, false, func() (string, error) {
+		var schema sql.NullString
+		err := db.QueryRowContext(ctx, SQL).Scan(&schema)
+		return schema.String, err
+	}, "currentSchema")
+	if err == nil {
+		location.Schema = schema
+	}
+	return location
+}
+
+// applyDefaults fills catalog and schema derived from the connector DSN (or session) when not provided.
+func (s *Service) applyDefaults(ctx context.Context, conn *connector.Connector, db *sql.DB, catalog, schema *string) {
+	if conn == nil || (*catalog != "" && *schema != "") {
+		return
+	}
+	location := s.defaultLocation(ctx, conn, db)
+	if *catalog == "" {
+		*catalog = location.Catalog
+	}
+	if *schema == "" {
+		*schema = location.Schema
 	}
-	return ""
 }
 
 // listColumns executes the metadata query and fills the output on success.
@@ -144,24 +223,58 @@ func (s *Service) listColumns(ctx context.Context, input *ListColumnsInput, out
 	if err != nil {
 		return err
 	}
-	if input.Schema == "" {
-		input.Schema = extractSchema(conn)
-	}
-	m := metadata.New()
-	var columns []sink.Column
+	s.applyDefaults(ctx, conn, db, &input.Catalog, &input.Schema)
 	started := time.Now()
-	log.Printf("mcp-sqlkit dbListColumns start connector=%q driver=%q catalog=%q schema=%q table=%q", requestedConnector, conn.Driver, input.Catalog, input.Schema, input.Table)
-	if err := m.Info(ctx, db, info.KindTable, &columns, s.metadataOptions(conn, input.Catalog, input.Schema, input.Table)...); err != nil {
+	log.Printf("mcp-sqlkit dbListColumns start connector=%q driver=%q catalog=%q schema=%q table=%q refresh=%v", requestedConnector, conn.Driver, input.Catalog, input.Schema, input.Table, input.Refresh)
+	columns, hit, err := s.columns(ctx, conn, db, input.Catalog, input.Schema, input.Table, input.Refresh)
+	if err != nil {
 		log.Printf("mcp-sqlkit dbListColumns error connector=%q driver=%q catalog=%q schema=%q table=%q elapsed=%s err=%v", requestedConnector, conn.Driver, input.Catalog, input.Schema, input.Table, time.Since(started), err)
 		return err
 	}
-	log.Printf("mcp-sqlkit dbListColumns done connector=%q driver=%q catalog=%q schema=%q table=%q columns=%d elapsed=%s", requestedConnector, conn.Driver, input.Catalog, input.Schema, input.Table, len(columns), time.Since(started))
+	log.Printf("mcp-sqlkit dbListColumns done connector=%q driver=%q catalog=%q schema=%q table=%q columns=%d cached=%v elapsed=%s", requestedConnector, conn.Driver, input.Catalog, input.Schema, input.Table, len(columns), hit, time.Since(started))
 
 	out.Connector = requestedConnector
+	out.Cached = hit
+	columns = filterColumns(columns, input.Pattern)
+	out.Page = &PageInfo{}
+	start, end := input.Page.bounds(len(columns), out.Page)
+	columns = columns[start:end]
+	if annotation := s.tableAnnotation(ctx, conn.Name, input.Schema, input.Table); annotation != nil {
+		for _, column := range columns {
+			if columnAnnotation := annotation.Column(column.Name); columnAnnotation != nil {
+				if out.Annotations == nil {
+					out.Annotations = map[string]*Annotation{}
+				}
+				out.Annotations[column.Name] = columnAnnotation
+			}
+		}
+	}
+	if input.Compact {
+		out.Items = columnEntries(columns)
+		return nil
+	}
 	out.Data = columns
 	return nil
 }
 
+// tables returns catalog/schema tables, served from the cache unless refresh is set.
+func (s *Service) tables(ctx context.Context, conn *connector.Connector, db *sql.DB, catalog, schema string, refresh bool) ([]sink.Table, bool, error) {
+	return cached(ctx, s, conn, refresh, func() ([]sink.Table, error) {
+		var tables []sink.Table
+		err := metadata.New().Info(ctx, db, info.KindTables, &tables, s.metadataOptions(conn, catalog, schema)...)
+		return tables, err
+	}, info.KindTables, catalog, schema)
+}
+
+// columns returns table columns, served from the cache unless refresh is set.
+func (s *Service) columns(ctx context.Context, conn *connector.Connector, db *sql.DB, catalog, schema, table string, refresh bool) ([]sink.Column, bool, error) {
+	return cached(ctx, s, conn, refresh, func() ([]sink.Column, error) {
+		var columns []sink.Column
+		err := metadata.New().Info(ctx, db, info.KindTable, &columns, s.metadataOptions(conn, catalog, schema, table)...)
+		return columns, err
+	}, info.KindTable, catalog, schema, table)
+}
+
 func (s *Service) metadataOptions(conn *connector.Connector, args ...interface{}) []option.Option {
 	options := []option.Option{option.NewArgs(args...)}
 	if product := metadataProduct(conn); product != nil {
@@ -170,13 +283,22 @@ func (s *Service) metadataOptions(conn *connector.Connector, args ...interface{}
 	return options
 }
 
+// metadataProducts maps connector drivers to sqlx products; detection by driver
+// type falls back to ANSI for some drivers (e.g. go-ora) and costs a version query.
+var metadataProducts = map[string]func() *database.Product{
+	"bigquery": bigqueryproduct.BigQuery,
+	"mysql":    mysqlproduct.MySQL5,
+	"oracle":   oracleproduct.Oracle,
+	"postgres": pgproduct.PqSQL9,
+	"sqlite":   sqliteproduct.SQLite3,
+}
+
 func metadataProduct(conn *connector.Connector) *database.Product {
 	if conn == nil {
 		return nil
 	}
-	switch strings.ToLower(conn.Driver) {
-	case "bigquery":
-		return bigqueryproduct.BigQuery()
+	if product, ok := metadataProducts[dialect.Of(conn.Driver).Driver]; ok {
+		return product()
 	}
 	return nil
 }
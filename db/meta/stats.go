@@ -0,0 +1,224 @@
+package meta
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+)
+
+const maxExactTables = 20
+
+// TableStatsInput defines parameters for retrieving table statistics.
+type TableStatsInput struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Catalog/database name (optional).
+	Catalog string `json:"catalog,omitempty"`
+
+	// Schema name (optional – defaults to the connector schema).
+	Schema string `json:"schema,omitempty"`
+
+	// Tables limits statistics to the listed tables (optional).
+	Tables []string `json:"tables,omitempty"`
+
+	// Pattern filters table names (substring, glob * ? or SQL LIKE %).
+	Pattern string `json:"pattern,omitempty"`
+
+	// Exact runs COUNT(*) for each returned table (full scans, max 20 tables).
+	Exact bool `json:"exact,omitempty"`
+
+	Page
+}
+
+// TableStatsOutput wraps table statistics.
+type TableStatsOutput struct {
+	Data      []*TableStats `json:"data,omitempty"`
+	Page      *PageInfo     `json:"page,omitempty"`
+	Status    string        `json:"status"`
+	Error     string        `json:"error,omitempty"`
+	Connector string        `json:"connector,omitempty"`
+}
+
+// TableStats holds catalog statistics of a table; values are approximate unless RowsExact is set.
+type TableStats struct {
+	Table        string `json:"table"`
+	Rows         *int64 `json:"rows,omitempty"`
+	RowsExact    bool   `json:"rowsExact,omitempty"`
+	SizeBytes    *int64 `json:"sizeBytes,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	LastAnalyzed string `json:"lastAnalyzed,omitempty"`
+	Partitioning string `json:"partitioning,omitempty"`
+	Partitions   int64  `json:"partitions,omitempty"`
+}
+
+// statsQueries return table, rows, size, last modified, last analyzed, partitioning and partition count.
+var statsQueries = map[string]string{
+	"mysql": `SELECT t.TABLE_NAME, t.TABLE_ROWS, t.DATA_LENGTH + t.INDEX_LENGTH, CAST(COALESCE(t.UPDATE_TIME, t.CREATE_TIME) AS CHAR), NULL, p.METHOD, p.PARTITIONS
+FROM information_schema.TABLES t
+LEFT JOIN (SELECT TABLE_NAME, GROUP_CONCAT(DISTINCT PARTITION_METHOD) AS METHOD, COUNT(*) AS PARTITIONS
+	FROM information_schema.PARTITIONS
+	WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND PARTITION_NAME IS NOT NULL
+	GROUP BY TABLE_NAME) p ON p.TABLE_NAME = t.TABLE_NAME
+WHERE t.TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND t.TABLE_TYPE = 'BASE TABLE'`,
+	"postgres": `SELECT c.relname, CASE WHEN c.reltuples >= 0 THEN c.reltuples::bigint ELSE s.n_live_tup END, pg_total_relation_size(c.oid), NULL,
+GREATEST(s.last_analyze, s.last_autoanalyze)::text,
+CASE pt.partstrat WHEN 'r' THEN 'RANGE' WHEN 'l' THEN 'LIST' WHEN 'h' THEN 'HASH' END,
+(SELECT count(*) FROM pg_inherits i WHERE i.inhparent = c.oid)
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+LEFT JOIN pg_stat_user_tables s ON s.relid = c.oid
+LEFT JOIN pg_partitioned_table pt ON pt.partrelid = c.oid
+WHERE c.relkind IN ('r', 'p') AND NOT c.relispartition AND n.nspname = COALESCE(NULLIF($1, ''), current_schema())`,
+	"oracle": `SELECT t.TABLE_NAME, t.NUM_ROWS, NULL, NULL, TO_CHAR(t.LAST_ANALYZED, 'YYYY-MM-DD HH24:MI:SS'), p.PARTITIONING_TYPE, p.PARTITION_COUNT
+FROM ALL_TABLES t
+LEFT JOIN ALL_PART_TABLES p ON p.OWNER = t.OWNER AND p.TABLE_NAME = t.TABLE_NAME
+WHERE t.OWNER = NVL(:1, SYS_CONTEXT('USERENV','CURRENT_SCHEMA'))`,
+}
+
+// TableStats returns approximate row counts, storage size, freshness and partitioning of tables.
+func (s *Service) TableStats(ctx context.Context, input *TableStatsInput) *TableStatsOutput {
+	out := &TableStatsOutput{Status: "ok"}
+	if input == nil {
+		input = &TableStatsInput{}
+	}
+	if err := s.tableStats(ctx, input, out); err != nil {
+		out.Status = "error"
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func (s *Service) tableStats(ctx context.Context, input *TableStatsInput, out *TableStatsOutput) error {
+	requestedConnector := input.Connector
+	conn, db, err := s.connection(ctx, requestedConnector)
+	if err != nil {
+		return err
+	}
+	s.applyDefaults(ctx, conn, db, &input.Catalog, &input.Schema)
+	aDialect := dialect.Of(conn.Driver)
+	started := time.Now()
+	log.Printf("mcp-sqlkit dbTableStats start connector=%q driver=%q catalog=%q schema=%q", requestedConnector, conn.Driver, input.Catalog, input.Schema)
+	stats, err := s.loadStats(ctx, conn, db, aDialect, input.Catalog, input.Schema)
+	if err != nil {
+		log.Printf("mcp-sqlkit dbTableStats error connector=%q driver=%q elapsed=%s err=%v", requestedConnector, conn.Driver, time.Since(started), err)
+		return err
+	}
+	var filtered []*TableStats
+	for _, item := range stats {
+		if !matchName(input.Pattern, item.Table) || (len(input.Tables) > 0 && !containsFold(input.Tables, item.Table)) {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+	sort.Slice(filtered, func(i, j int) bool { return filtered[i].Table < filtered[j].Table })
+	out.Page = &PageInfo{}
+	start, end := input.Page.bounds(len(filtered), out.Page)
+	filtered = filtered[start:end]
+	if input.Exact {
+		if len(filtered) > maxExactTables {
+			return fmt.Errorf("exact counts are limited to %d tables: narrow with tables, pattern or limit", maxExactTables)
+		}
+		for _, item := range filtered {
+			var count int64
+			name := aDialect.QuoteIdentifier(item.Table)
+			if input.Schema != "" && !aDialect.Is("sqlite") {
+				name = aDialect.QuoteIdentifier(input.Schema) + "." + name
+			}
+			if err = db.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+name).Scan(&count); err != nil {
+				return fmt.Errorf("failed to count %v: %w", item.Table, err)
+			}
+			item.Rows, item.RowsExact = &count, true
+		}
+	}
+	log.Printf("mcp-sqlkit dbTableStats done connector=%q driver=%q tables=%d elapsed=%s", requestedConnector, conn.Driver, len(filtered), time.Since(started))
+	out.Connector = requestedConnector
+	out.Data = filtered
+	return nil
+}
+
+func (s *Service) loadStats(ctx context.Context, conn *connector.Connector, db *sql.DB, d *dialect.Dialect, catalog, schema string) ([]*TableStats, error) {
+	switch d.Driver {
+	case "sqlite":
+		return sqliteStats(ctx, db)
+	case "bigquery":
+		dataset := "`" + qualified(catalog, schema) + "`"
+		return queryStats(ctx, db, "SELECT t.table_id, t.row_count, t.size_bytes, CAST(TIMESTAMP_MILLIS(t.last_modified_time) AS STRING), CAST(NULL AS STRING), p.columns, CAST(NULL AS INT64) "+
+			"FROM "+dataset+".__TABLES__ t "+
+			"LEFT JOIN (SELECT table_name, STRING_AGG(column_name) AS columns FROM "+dataset+".INFORMATION_SCHEMA.COLUMNS WHERE is_partitioning_column = 'YES' GROUP BY table_name) p ON p.table_name = t.table_id "+
+			"WHERE t.type = 1")
+	case "mysql":
+		return queryStats(ctx, db, statsQueries[d.Driver], schema, schema)
+	}
+	SQL, ok := statsQueries[d.Driver]
+	if !ok {
+		return nil, fmt.Errorf("table statistics are not supported for driver %v", conn.Driver)
+	}
+	return queryStats(ctx, db, SQL, schema)
+}
+
+func queryStats(ctx context.Context, db *sql.DB, SQL string, args ...interface{}) ([]*TableStats, error) {
+	rows, err := db.QueryContext(ctx, SQL, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*TableStats
+	for rows.Next() {
+		var name string
+		var count, size, partitions sql.NullInt64
+		var modified, analyzed, partitioning sql.NullString
+		if err = rows.Scan(&name, &count, &size, &modified, &analyzed, &partitioning, &partitions); err != nil {
+			return nil, err
+		}
+		item := &TableStats{Table: name, LastModified: modified.String, LastAnalyzed: analyzed.String, Partitioning: partitioning.String, Partitions: partitions.Int64}
+		if count.Valid {
+			item.Rows = &count.Int64
+		}
+		if size.Valid {
+			item.SizeBytes = &size.Int64
+		}
+		result = append(result, item)
+	}
+	return result, rows.Err()
+}
+
+// sqliteStats reads row estimates from sqlite_stat1 (after ANALYZE) and sizes
+// from the dbstat virtual table; both are optional in SQLite builds.
+func sqliteStats(ctx context.Context, db *sql.DB) ([]*TableStats, error) {
+	stats, err := queryStats(ctx, db, "SELECT name, NULL, NULL, NULL, NULL, NULL, NULL FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		return nil, err
+	}
+	byName := map[string]*TableStats{}
+	for _, item := range stats {
+		byName[item.Table] = item
+	}
+	scan := func(SQL string, apply func(item *TableStats, value int64)) {
+		rows, err := db.QueryContext(ctx, SQL)
+		if err != nil {
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			var value int64
+			if rows.Scan(&name, &value) == nil && byName[name] != nil {
+				apply(byName[name], value)
+			}
+		}
+	}
+	scan("SELECT tbl, MAX(CAST(substr(stat, 1, instr(stat || ' ', ' ') - 1) AS INTEGER)) FROM sqlite_stat1 GROUP BY tbl", func(item *TableStats, value int64) {
+		item.Rows = &value
+	})
+	scan("SELECT name, SUM(pgsize) FROM dbstat GROUP BY name", func(item *TableStats, value int64) {
+		item.SizeBytes = &value
+	})
+	return stats, nil
+}
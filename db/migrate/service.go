@@ -0,0 +1,372 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/viant/afs"
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/mcp-sqlkit/db/exec"
+)
+
+const defaultTable = "schema_migrations"
+
+const (
+	// ActionStatus reports migration states without changes.
+	ActionStatus = "status"
+	// ActionUp applies pending migrations up to the target version.
+	ActionUp = "up"
+	// ActionDown reverts applied migrations down to the target version.
+	ActionDown = "down"
+)
+
+// Input defines parameters for running schema migrations.
+type Input struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// URL of the afs location holding <version>_<name>.up.sql / .down.sql scripts.
+	URL string `json:"url"`
+
+	// Action to perform (default status).
+	Action string `json:"action,omitempty" choice:"status" choice:"up" choice:"down"`
+
+	// Target version: up applies migrations <= target (default latest); down reverts migrations > target (default previous version).
+	Target *int64 `json:"target,omitempty"`
+
+	// Table tracking applied versions (default schema_migrations).
+	Table string `json:"table,omitempty"`
+}
+
+// Output follows the same envelope format used by other DB tools.
+type Output struct {
+	Current    int64        `json:"current"`
+	Executed   []*Migration `json:"executed,omitempty"`
+	Migrations []*Migration `json:"migrations,omitempty"`
+	Status     string       `json:"status"`
+	Error      string       `json:"error,omitempty"`
+	Connector  string       `json:"connector,omitempty"`
+}
+
+// Service applies versioned migrations to connector databases.
+type Service struct {
+	connectors *connector.Service
+	fs         afs.Service
+}
+
+// New creates a migration service instance.
+func New(connectors *connector.Service) *Service {
+	return &Service{connectors: connectors, fs: afs.New()}
+}
+
+// Migrate reports status, applies or reverts migrations.
+func (s *Service) Migrate(ctx context.Context, input *Input) *Output {
+	output := &Output{Status: "ok"}
+	if input == nil {
+		input = &Input{}
+	}
+	if err := s.migrate(ctx, input, output); err != nil {
+		output.Status = "error"
+		output.Error = err.Error()
+	}
+	output.Connector = input.Connector
+	return output
+}
+
+type applied struct {
+	checksum  string
+	appliedAt string
+}
+
+func (s *Service) migrate(ctx context.Context, input *Input, output *Output) error {
+	if input.URL == "" {
+		return fmt.Errorf("url was empty")
+	}
+	action := strings.ToLower(input.Action)
+	if action == "" {
+		action = ActionStatus
+	}
+	table := input.Table
+	if table == "" {
+		table = defaultTable
+	}
+	con, err := s.connectors.Connection(ctx, input.Connector)
+	if err != nil {
+		return err
+	}
+	db, err := con.Db(ctx)
+	if err != nil {
+		return err
+	}
+	aDialect := dialect.Of(con.Driver)
+	migrations, err := loadMigrations(ctx, s.fs, input.URL)
+	if err != nil {
+		return err
+	}
+	history, exists, err := s.history(ctx, db, aDialect, table)
+	if err != nil {
+		return err
+	}
+	status, current, drifted := merge(migrations, history)
+	output.Migrations = status
+	output.Current = current
+
+	switch action {
+	case ActionStatus:
+		return nil
+	case ActionUp, ActionDown:
+	default:
+		return fmt.Errorf("unsupported action: %v", input.Action)
+	}
+	if len(drifted) > 0 {
+		return fmt.Errorf("refusing to %v: applied migrations changed or missing: %v", action, strings.Join(drifted, ", "))
+	}
+	if !exists {
+		if _, err = db.ExecContext(ctx, trackingTableDDL(aDialect, table)); err != nil {
+			return fmt.Errorf("failed to create migration table %v: %w", table, err)
+		}
+	}
+	if action == ActionUp {
+		err = s.up(ctx, con, db, aDialect, table, status, input.Target, output)
+	} else {
+		err = s.down(ctx, con, db, aDialect, table, status, input.Target, output)
+	}
+	if len(output.Executed) > 0 {
+		s.connectors.SchemaChanged(ctx, con.Name)
+	}
+	output.Current = currentVersion(status)
+	return err
+}
+
+func (s *Service) up(ctx context.Context, con *connector.Connector, db *sql.DB, d *dialect.Dialect, table string, migrations []*Migration, target *int64, output *Output) error {
+	insertSQL := "INSERT INTO " + d.QuoteIdentifier(table) + " (version, name, checksum, applied_at) VALUES (" +
+		d.Placeholder(1) + ", " + d.Placeholder(2) + ", " + d.Placeholder(3) + ", " + d.Placeholder(4) + ")"
+	for _, migration := range migrations {
+		if migration.State != StatePending || (target != nil && migration.Version > *target) {
+			continue
+		}
+		appliedAt := time.Now().UTC()
+		err := exec.Transact(ctx, db, con.Driver, func(ctx context.Context, executor exec.Executor) error {
+			if err := run(ctx, executor, migration.up, d.Is("oracle")); err != nil {
+				return err
+			}
+			_, err := executor.ExecContext(ctx, insertSQL, migration.Version, migration.Name, migration.Checksum, appliedAt)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("migration %v_%v failed: %w", migration.Version, migration.Name, err)
+		}
+		migration.State = StateApplied
+		migration.AppliedAt = appliedAt.Format(time.RFC3339)
+		output.Executed = append(output.Executed, migration)
+	}
+	return nil
+}
+
+func (s *Service) down(ctx context.Context, con *connector.Connector, db *sql.DB, d *dialect.Dialect, table string, migrations []*Migration, target *int64, output *Output) error {
+	var appliedVersions []*Migration
+	for _, migration := range migrations {
+		if migration.State == StateApplied {
+			appliedVersions = append(appliedVersions, migration)
+		}
+	}
+	if len(appliedVersions) == 0 {
+		return nil
+	}
+	floor := int64(0)
+	if target != nil {
+		floor = *target
+	} else if len(appliedVersions) > 1 {
+		floor = appliedVersions[len(appliedVersions)-2].Version
+	}
+	deleteSQL := "DELETE FROM " + d.QuoteIdentifier(table) + " WHERE version = " + d.Placeholder(1)
+	for i := len(appliedVersions) - 1; i >= 0; i-- {
+		migration := appliedVersions[i]
+		if migration.Version <= floor {
+			break
+		}
+		if !migration.hasDown {
+			return fmt.Errorf("migration %v_%v has no down script", migration.Version, migration.Name)
+		}
+		err := exec.Transact(ctx, db, con.Driver, func(ctx context.Context, executor exec.Executor) error {
+			if err := run(ctx, executor, migration.down, d.Is("oracle")); err != nil {
+				return err
+			}
+			_, err := executor.ExecContext(ctx, deleteSQL, migration.Version)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("migration %v_%v rollback failed: %w", migration.Version, migration.Name, err)
+		}
+		migration.State = StatePending
+		migration.AppliedAt = ""
+		output.Executed = append(output.Executed, migration)
+	}
+	return nil
+}
+
+func run(ctx context.Context, executor exec.Executor, script string, plsql bool) error {
+	for _, stmt := range splitStatements(script, plsql) {
+		if _, err := executor.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// history reads applied migrations; exists is false when tracking table is missing.
+func (s *Service) history(ctx context.Context, db *sql.DB, d *dialect.Dialect, table string) (map[int64]*applied, bool, error) {
+	exists, err := tableExists(ctx, db, d, table)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to check migration table %v: %w", table, err)
+	}
+	if !exists {
+		return map[int64]*applied{}, false, nil
+	}
+	rows, err := db.QueryContext(ctx, "SELECT version, checksum, applied_at FROM "+d.QuoteIdentifier(table))
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to read migration table %v: %w", table, err)
+	}
+	defer rows.Close()
+	result := map[int64]*applied{}
+	for rows.Next() {
+		var version int64
+		var sum string
+		var appliedAt interface{}
+		if err := rows.Scan(&version, &sum, &appliedAt); err != nil {
+			return nil, true, err
+		}
+		result[version] = &applied{checksum: sum, appliedAt: formatTime(appliedAt)}
+	}
+	return result, true, rows.Err()
+}
+
+// tableExists looks a possibly schema qualified table up in the database catalog;
+// unqualified tables are searched in the session's current schema.
+func tableExists(ctx context.Context, db *sql.DB, d *dialect.Dialect, table string) (bool, error) {
+	schema, name := "", table
+	if index := strings.LastIndex(table, "."); index != -1 {
+		schema, name = table[:index], table[index+1:]
+	}
+	args := []interface{}{catalogName(d, name)}
+	schemaExpr := func(current string) string {
+		if schema == "" {
+			return current
+		}
+		args = append(args, catalogName(d, schema))
+		return d.Placeholder(len(args))
+	}
+	var SQL string
+	switch {
+	case d.Is("sqlite"):
+		prefix := ""
+		if schema != "" {
+			prefix = d.QuoteIdentifier(schema) + "."
+		}
+		SQL = "SELECT COUNT(*) FROM " + prefix + "sqlite_master WHERE type = 'table' AND name = ?"
+	case d.Is("oracle"):
+		SQL = "SELECT COUNT(*) FROM ALL_TABLES WHERE TABLE_NAME = :1 AND OWNER = " + schemaExpr("SYS_CONTEXT('USERENV', 'CURRENT_SCHEMA')")
+	case d.Is("bigquery"):
+		prefix := ""
+		if schema != "" {
+			prefix = d.QuoteIdentifier(schema) + "."
+		}
+		SQL = "SELECT COUNT(*) FROM " + prefix + "INFORMATION_SCHEMA.TABLES WHERE table_name = ?"
+	case d.Is("mysql"):
+		SQL = "SELECT COUNT(*) FROM information_schema.TABLES WHERE TABLE_NAME = ? AND TABLE_SCHEMA = " + schemaExpr("DATABASE()")
+	default:
+		SQL = "SELECT COUNT(*) FROM information_schema.tables WHERE table_name = " + d.Placeholder(1) + " AND table_schema = " + schemaExpr("current_schema()")
+	}
+	var count int
+	if err := db.QueryRowContext(ctx, SQL, args...).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// catalogName returns the catalog spelling of an identifier: unquoted identifiers
+// are stored upper case by Oracle and lower case by Postgres.
+func catalogName(d *dialect.Dialect, name string) string {
+	if d.QuoteIdentifier(name) != name {
+		return name
+	}
+	switch {
+	case d.Is("oracle"):
+		return strings.ToUpper(name)
+	case d.Is("postgres"):
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+// merge combines source migrations with applied history and reports drifted or missing versions.
+func merge(migrations []*Migration, history map[int64]*applied) ([]*Migration, int64, []string) {
+	var drifted []string
+	seen := map[int64]bool{}
+	result := make([]*Migration, 0, len(migrations))
+	for _, migration := range migrations {
+		seen[migration.Version] = true
+		migration.State = StatePending
+		if entry, ok := history[migration.Version]; ok {
+			migration.AppliedAt = entry.appliedAt
+			migration.State = StateApplied
+			if entry.checksum != migration.Checksum {
+				migration.State = StateDrifted
+				drifted = append(drifted, fmt.Sprintf("%v_%v", migration.Version, migration.Name))
+			}
+		}
+		result = append(result, migration)
+	}
+	var missing []int64
+	for version := range history {
+		if !seen[version] {
+			missing = append(missing, version)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i] < missing[j] })
+	for _, version := range missing {
+		entry := history[version]
+		result = append(result, &Migration{Version: version, State: StateMissing, Checksum: entry.checksum, AppliedAt: entry.appliedAt})
+		drifted = append(drifted, fmt.Sprintf("%v", version))
+	}
+	return result, currentVersion(result), drifted
+}
+
+func currentVersion(migrations []*Migration) int64 {
+	var current int64
+	for _, migration := range migrations {
+		if migration.State != StatePending && migration.Version > current {
+			current = migration.Version
+		}
+	}
+	return current
+}
+
+func trackingTableDDL(d *dialect.Dialect, table string) string {
+	primaryKey := " PRIMARY KEY"
+	if d.Is("bigquery") {
+		primaryKey = ""
+	}
+	return "CREATE TABLE " + d.QuoteIdentifier(table) + " (version " + d.TypeName(dialect.TypeInteger) + primaryKey +
+		", name " + d.TypeName(dialect.TypeText) + ", checksum " + d.TypeName(dialect.TypeText) +
+		", applied_at " + d.TypeName(dialect.TypeTimestamp) + ")"
+}
+
+func formatTime(value interface{}) string {
+	switch actual := value.(type) {
+	case time.Time:
+		return actual.UTC().Format(time.RFC3339)
+	case []byte:
+		return string(actual)
+	case string:
+		return actual
+	case nil:
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,149 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/viant/afs"
+	_ "github.com/viant/afs/mem"
+	_ "modernc.org/sqlite" // register SQLite driver
+
+	"github.com/viant/mcp-sqlkit/auth"
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/mcp-sqlkit/policy"
+	"github.com/viant/scy"
+)
+
+func TestSplitStatements(t *testing.T) {
+	type testCase struct {
+		name   string
+		script string
+		plsql  bool
+		expect []string
+	}
+	testCases := []testCase{
+		{
+			name:   "quotes and comments",
+			script: "CREATE TABLE a(id INT); -- comment; here\nINSERT INTO a VALUES(';');\n/* block; */ DROP TABLE b",
+			expect: []string{"CREATE TABLE a(id INT)", "INSERT INTO a VALUES(';')", "DROP TABLE b"},
+		},
+		{
+			name:   "dollar quoted body",
+			script: "CREATE FUNCTION f() RETURNS INT AS $$ BEGIN RETURN 1; END; $$ LANGUAGE plpgsql;\nCREATE FUNCTION g() RETURNS INT AS $body$ SELECT $1; $body$ LANGUAGE sql;\nSELECT f()",
+			expect: []string{
+				"CREATE FUNCTION f() RETURNS INT AS $$ BEGIN RETURN 1; END; $$ LANGUAGE plpgsql",
+				"CREATE FUNCTION g() RETURNS INT AS $body$ SELECT $1; $body$ LANGUAGE sql",
+				"SELECT f()",
+			},
+		},
+		{
+			name:   "plsql block",
+			script: "CREATE TABLE a(id NUMBER);\nCREATE OR REPLACE PROCEDURE p AS\nBEGIN\n  DELETE FROM a;\n  COMMIT;\nEND;\n/\nBEGIN\n  p;\nEND;\n/\nDROP TABLE a;",
+			plsql:  true,
+			expect: []string{"CREATE TABLE a(id NUMBER)", "CREATE OR REPLACE PROCEDURE p AS\nBEGIN\n  DELETE FROM a;\n  COMMIT;\nEND;", "BEGIN\n  p;\nEND;", "DROP TABLE a"},
+		},
+		{
+			name:   "slash line",
+			script: "SELECT 4 / 2 FROM dual\n/\nSELECT 1 FROM dual",
+			expect: []string{"SELECT 4 / 2 FROM dual", "SELECT 1 FROM dual"},
+		},
+		{
+			name:   "no split",
+			script: "-- migrate:no-split\nCREATE PROCEDURE p() BEGIN SELECT 1; SELECT 2; END",
+			expect: []string{"-- migrate:no-split\nCREATE PROCEDURE p() BEGIN SELECT 1; SELECT 2; END"},
+		},
+	}
+	for _, tc := range testCases {
+		assert.EqualValues(t, tc.expect, splitStatements(tc.script, tc.plsql), tc.name)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	migrations := []*Migration{{Version: 2, Name: "orders", Checksum: "b"}}
+	history := map[int64]*applied{5: {checksum: "e"}, 1: {checksum: "a"}, 2: {checksum: "b"}, 4: {checksum: "d"}, 3: {checksum: "c"}}
+	for i := 0; i < 10; i++ {
+		status, current, drifted := merge(migrations, history)
+		assert.EqualValues(t, []string{"1", "3", "4", "5"}, drifted)
+		assert.EqualValues(t, 5, current)
+		var versions []int64
+		for _, migration := range status {
+			versions = append(versions, migration.Version)
+		}
+		assert.EqualValues(t, []int64{2, 1, 3, 4, 5}, versions)
+	}
+}
+
+func TestService_Migrate(t *testing.T) {
+	cfg := &connector.Config{}
+	mgr := connector.NewManager(cfg, auth.New(&policy.Policy{}), scy.New())
+	connSvc := connector.NewService(mgr, nil)
+	service := New(connSvc)
+
+	ctx := context.Background()
+	conn := &connector.Connector{Name: "testConn", Driver: "sqlite", DSN: "file:migratedb?mode=memory&cache=shared"}
+	pend, err := connSvc.GeneratePendingSecret(ctx, conn)
+	require.NoError(t, err)
+	pend.NS.Connectors.Put(conn.Name, conn)
+	db, err := conn.Db(ctx)
+	require.NoError(t, err)
+
+	fs := afs.New()
+	baseURL := "mem://localhost/migrations"
+	files := map[string]string{
+		"001_users.up.sql":    "CREATE TABLE users(id INTEGER PRIMARY KEY, name TEXT);",
+		"001_users.down.sql":  "DROP TABLE users;",
+		"002_orders.up.sql":   "CREATE TABLE orders(id INTEGER PRIMARY KEY); CREATE INDEX orders_id ON orders(id);",
+		"002_orders.down.sql": "DROP TABLE orders;",
+	}
+	for name, content := range files {
+		require.NoError(t, fs.Upload(ctx, baseURL+"/"+name, 0644, strings.NewReader(content)))
+	}
+
+	out := service.Migrate(ctx, &Input{Connector: "testConn", URL: baseURL})
+	require.Equal(t, "ok", out.Status, out.Error)
+	require.Len(t, out.Migrations, 2)
+	assert.EqualValues(t, StatePending, out.Migrations[0].State)
+
+	target := int64(1)
+	out = service.Migrate(ctx, &Input{Connector: "testConn", URL: baseURL, Action: ActionUp, Target: &target})
+	require.Equal(t, "ok", out.Status, out.Error)
+	assert.EqualValues(t, 1, out.Current)
+	assert.Len(t, out.Executed, 1)
+
+	out = service.Migrate(ctx, &Input{Connector: "testConn", URL: baseURL, Action: ActionUp})
+	require.Equal(t, "ok", out.Status, out.Error)
+	assert.EqualValues(t, 2, out.Current)
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM orders").Scan(&count))
+
+	out = service.Migrate(ctx, &Input{Connector: "testConn", URL: baseURL, Action: ActionDown})
+	require.Equal(t, "ok", out.Status, out.Error)
+	assert.EqualValues(t, 1, out.Current)
+	_, err = db.ExecContext(ctx, "SELECT COUNT(*) FROM orders")
+	assert.Error(t, err)
+
+	// changing an applied script must block further changes
+	require.NoError(t, fs.Upload(ctx, baseURL+"/001_users.up.sql", 0644, strings.NewReader("CREATE TABLE users(id INTEGER);")))
+	out = service.Migrate(ctx, &Input{Connector: "testConn", URL: baseURL, Action: ActionUp})
+	assert.Equal(t, "error", out.Status)
+	assert.Contains(t, out.Error, "1_users")
+	assert.EqualValues(t, StateDrifted, out.Migrations[0].State)
+
+	// catalog errors must not be reported as a missing tracking table
+	closed, err := sql.Open("sqlite", "file:migratedb?mode=memory&cache=shared")
+	require.NoError(t, err)
+	require.NoError(t, closed.Close())
+	_, _, err = service.history(ctx, closed, dialect.Of("sqlite"), defaultTable)
+	assert.Error(t, err)
+	exists, err := tableExists(ctx, db, dialect.Of("sqlite"), "main."+defaultTable)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = tableExists(ctx, db, dialect.Of("sqlite"), "other_migrations")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,210 @@
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/viant/afs"
+)
+
+// Migration represents a versioned migration and its state on the target connector.
+type Migration struct {
+	Version   int64  `json:"version"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	Checksum  string `json:"checksum,omitempty"`
+	AppliedAt string `json:"appliedAt,omitempty"`
+	up        string
+	down      string
+	hasDown   bool
+}
+
+const (
+	// StatePending marks migration not yet applied.
+	StatePending = "pending"
+	// StateApplied marks applied migration with matching checksum.
+	StateApplied = "applied"
+	// StateDrifted marks applied migration whose script changed since it was applied.
+	StateDrifted = "drifted"
+	// StateMissing marks applied migration without a source script.
+	StateMissing = "missing"
+)
+
+// loadMigrations reads migration scripts from baseURL. Supported file names:
+//
+//	<version>_<name>.up.sql, <version>_<name>.down.sql or <version>_<name>.sql (up only)
+func loadMigrations(ctx context.Context, fs afs.Service, baseURL string) ([]*Migration, error) {
+	objects, err := fs.List(ctx, baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations %v: %w", baseURL, err)
+	}
+	byVersion := map[int64]*Migration{}
+	for _, object := range objects {
+		if object.IsDir() || !strings.HasSuffix(strings.ToLower(object.Name()), ".sql") {
+			continue
+		}
+		version, name, direction, ok := parseFileName(object.Name())
+		if !ok {
+			continue
+		}
+		data, err := fs.DownloadWithURL(ctx, object.URL())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %v: %w", object.URL(), err)
+		}
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		switch direction {
+		case "down":
+			migration.down = string(data)
+			migration.hasDown = true
+		default:
+			if migration.up != "" {
+				return nil, fmt.Errorf("duplicate migration version %v", version)
+			}
+			migration.up = string(data)
+			migration.Checksum = checksum(data)
+		}
+	}
+	result := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Checksum == "" {
+			return nil, fmt.Errorf("migration %v has no up script", migration.Version)
+		}
+		result = append(result, migration)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+func parseFileName(fileName string) (int64, string, string, bool) {
+	base := fileName[:len(fileName)-len(".sql")]
+	direction := "up"
+	lower := strings.ToLower(base)
+	switch {
+	case strings.HasSuffix(lower, ".up"):
+		base = base[:len(base)-3]
+	case strings.HasSuffix(lower, ".down"):
+		base = base[:len(base)-5]
+		direction = "down"
+	}
+	prefix, name, _ := strings.Cut(base, "_")
+	version, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil {
+		return 0, "", "", false
+	}
+	return version, name, direction, true
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// noSplitDirective at the top of a script runs it as a single statement, e.g. for
+// MySQL procedures whose bodies contain semicolons.
+const noSplitDirective = "-- migrate:no-split"
+
+// plsqlBlock matches statements whose body holds semicolons and which end at a
+// line holding a single slash (SQL*Plus convention).
+var plsqlBlock = regexp.MustCompile(`(?i)^(DECLARE|BEGIN|CREATE\s+(OR\s+REPLACE\s+)?((NON)?EDITIONABLE\s+)?(PROCEDURE|FUNCTION|PACKAGE|TRIGGER|TYPE))\b`)
+
+// dollarTag matches a Postgres dollar quote opening tag ($$ or $tag$).
+var dollarTag = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+
+// splitStatements splits a script on semicolons outside of quotes, dollar quoted
+// strings and comments; a line holding a single slash also ends a statement. With
+// plsql, PL/SQL blocks keep their semicolons and end at such a slash line.
+func splitStatements(script string, plsql bool) []string {
+	if strings.HasPrefix(strings.TrimSpace(script), noSplitDirective) {
+		if stmt := strings.TrimSpace(script); stmt != "" {
+			return []string{stmt}
+		}
+		return nil
+	}
+	var result []string
+	var current strings.Builder
+	var quote byte
+	flush := func() {
+		if stmt := strings.TrimSpace(current.String()); stmt != "" {
+			result = append(result, stmt)
+		}
+		current.Reset()
+	}
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case quote != 0:
+			current.WriteByte(c)
+			if c == quote {
+				quote = 0
+			}
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '$':
+			tag := dollarTag.FindString(script[i:])
+			if tag == "" {
+				break
+			}
+			end := strings.Index(script[i+len(tag):], tag)
+			if end == -1 {
+				current.WriteString(script[i:])
+				i = len(script)
+			} else {
+				current.WriteString(script[i : i+len(tag)+end+len(tag)])
+				i += len(tag) + end + len(tag) - 1
+			}
+			continue
+		case c == '-' && i+1 < len(script) && script[i+1] == '-':
+			end := strings.IndexByte(script[i:], '\n')
+			if end == -1 {
+				i = len(script)
+			} else {
+				i += end
+				current.WriteByte('\n')
+			}
+			continue
+		case c == '/' && i+1 < len(script) && script[i+1] == '*':
+			end := strings.Index(script[i+2:], "*/")
+			if end == -1 {
+				i = len(script)
+			} else {
+				i += end + 3
+			}
+			continue
+		case c == '/' && isSlashLine(script, i):
+			flush()
+			continue
+		case c == ';':
+			if plsql && plsqlBlock.MatchString(strings.TrimSpace(current.String())) {
+				break
+			}
+			flush()
+			continue
+		}
+		current.WriteByte(c)
+	}
+	flush()
+	return result
+}
+
+// isSlashLine reports whether the slash at position is the only character of its line.
+func isSlashLine(script string, position int) bool {
+	lineStart := strings.LastIndexByte(script[:position], '\n') + 1
+	lineEnd := strings.IndexByte(script[position:], '\n')
+	if lineEnd == -1 {
+		lineEnd = len(script)
+	} else {
+		lineEnd += position
+	}
+	return strings.TrimSpace(script[lineStart:position]) == "" && strings.TrimSpace(script[position+1:lineEnd]) == ""
+}
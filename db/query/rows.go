@@ -0,0 +1,41 @@
+package query
+
+import (
+	"database/sql"
+	"reflect"
+
+	"github.com/viant/sqlx/io"
+	"github.com/viant/sqlx/metadata/sink"
+)
+
+// ReadAll materializes rows of an already executed statement (e.g. DML with a
+// RETURNING clause) using the same record mapping as Query. Unlike Query it
+// never re-runs the statement to detect columns.
+func ReadAll(rows *sql.Rows) ([]interface{}, error) {
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]*sink.Column, 0, len(columnTypes))
+	for _, item := range io.TypesToColumns(columnTypes) {
+		column := &sink.Column{Name: item.Name(), Type: item.DatabaseTypeName()}
+		column.SetScanType(item.ScanType())
+		// driver nullability for returned rows is unreliable – always allow NULL
+		column.Nullable = "1"
+		columns = append(columns, column)
+	}
+	recordType := newRecordType(columns)
+	var result []interface{}
+	for rows.Next() {
+		record := reflect.New(recordType).Elem()
+		targets := make([]interface{}, record.NumField())
+		for i := range targets {
+			targets[i] = record.Field(i).Addr().Interface()
+		}
+		if err := rows.Scan(targets...); err != nil {
+			return nil, err
+		}
+		result = append(result, materializeRow(record.Interface()))
+	}
+	return result, rows.Err()
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/viant/sqlparser"
 	"github.com/viant/sqlx/io"
 	"github.com/viant/sqlx/io/read"
+	"github.com/viant/sqlx/metadata/sink"
 	text "github.com/viant/tagly/format/text"
 	"reflect"
 	"strconv"
@@ -112,49 +113,55 @@ func (r *Service) recordType(ctx context.Context, input *Input, db *sql.DB) (ref
 		if err != nil {
 			return nil, err
 		}
-		var (
-			fields    []reflect.StructField
-			usedNames = make(map[string]bool, len(columns))
-		)
-		for idx, column := range columns {
-			name := column.Name
-			if name == "" {
-				name = "c" + strconv.Itoa(idx)
-			}
-			columnCase := text.DetectCaseFormat(name)
-			fieldName := columnCase.To(text.CaseFormatUpperCamel).Format(name)
+		recordType = newRecordType(columns)
+		// Store for future reuse.
+		r.cache.Put(cacheKey, recordType)
+	}
+	return recordType, nil
+}
 
-			// Ensure the generated struct field name is a valid Go identifier that satisfies
-			// reflect.StructField requirements (exported, unique and non-empty).
-			// 1. Identifier must start with an upper-case letter so it is exported – many downstream
-			//    libraries (including sqlx) rely on being able to set the field via reflection.
-			// 2. It cannot start with a digit and may only contain letters, digits and underscore.
-			// 3. Field names within a single struct type must be unique.
-			if fieldName == "" {
-				fieldName = "X"
-			}
+// newRecordType builds an anonymous struct type with one exported field per
+// column; nullable columns use pointer fields.
+func newRecordType(columns []*sink.Column) reflect.Type {
+	var (
+		fields    []reflect.StructField
+		usedNames = make(map[string]bool, len(columns))
+	)
+	for idx, column := range columns {
+		name := column.Name
+		if name == "" {
+			name = "c" + strconv.Itoa(idx)
+		}
+		columnCase := text.DetectCaseFormat(name)
+		fieldName := columnCase.To(text.CaseFormatUpperCamel).Format(name)
 
-			// Replace invalid characters and make sure the first character is an upper-case letter.
-			fieldName = sanitizeIdentifier(fieldName)
+		// Ensure the generated struct field name is a valid Go identifier that satisfies
+		// reflect.StructField requirements (exported, unique and non-empty).
+		// 1. Identifier must start with an upper-case letter so it is exported – many downstream
+		//    libraries (including sqlx) rely on being able to set the field via reflection.
+		// 2. It cannot start with a digit and may only contain letters, digits and underscore.
+		// 3. Field names within a single struct type must be unique.
+		if fieldName == "" {
+			fieldName = "X"
+		}
 
-			// Resolve naming collisions that might arise after sanitisation (for example when two
-			// columns differ only by characters that have been stripped).
-			for usedNames[fieldName] {
-				fieldName += "_"
-			}
-			usedNames[fieldName] = true
-			scanType := column.ScanType()
-			if scanType.Kind() != reflect.Pointer && (column.Nullable == "1" || column.Nullable == "true") {
-				scanType = reflect.PointerTo(scanType)
-			}
-			field := reflect.StructField{Name: fieldName, Tag: reflect.StructTag(`sqlx:"` + name + `"`), Type: scanType}
-			fields = append(fields, field)
+		// Replace invalid characters and make sure the first character is an upper-case letter.
+		fieldName = sanitizeIdentifier(fieldName)
+
+		// Resolve naming collisions that might arise after sanitisation (for example when two
+		// columns differ only by characters that have been stripped).
+		for usedNames[fieldName] {
+			fieldName += "_"
 		}
-		recordType = reflect.StructOf(fields)
-		// Store for future reuse.
-		r.cache.Put(cacheKey, recordType)
+		usedNames[fieldName] = true
+		scanType := column.ScanType()
+		if scanType.Kind() != reflect.Pointer && (column.Nullable == "1" || column.Nullable == "true") {
+			scanType = reflect.PointerTo(scanType)
+		}
+		field := reflect.StructField{Name: fieldName, Tag: reflect.StructTag(`sqlx:"` + name + `"`), Type: scanType}
+		fields = append(fields, field)
 	}
-	return recordType, nil
+	return reflect.StructOf(fields)
 }
 
 func New(services *connector.Service) *Service {
@@ -0,0 +1,263 @@
+package upsert
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/mcp-sqlkit/db/exec"
+)
+
+const defaultBatchSize = 100
+
+// Input defines parameters for inserting or updating rows identified by key columns.
+type Input struct {
+	// Connector to use.
+	Connector string `json:"connector,omitempty"`
+
+	// Table to upsert into (optionally schema qualified).
+	Table string `json:"table"`
+
+	// Keys lists columns identifying a row (primary or unique key).
+	Keys []string `json:"keys"`
+
+	// Rows to insert or update, keyed by column name.
+	Rows []map[string]interface{} `json:"rows"`
+
+	// Columns optionally fixes the column list (missing row fields bind NULL); by default rows are
+	// grouped by their fields so that only supplied columns are written.
+	Columns []string `json:"columns,omitempty"`
+
+	// UpdateColumns lists columns updated on conflict; defaults to all non-key columns.
+	UpdateColumns []string `json:"updateColumns,omitempty"`
+
+	// BatchSize controls number of rows per statement (default 100).
+	BatchSize int `json:"batchSize,omitempty"`
+}
+
+// Output follows the same envelope format used by other DB tools.
+type Output struct {
+	RowsAffected int64  `json:"rowsAffected"`
+	Statements   int    `json:"statements,omitempty"`
+	Status       string `json:"status"`
+	Error        string `json:"error,omitempty"`
+	Connector    string `json:"connector,omitempty"`
+}
+
+// Service provides dialect aware upsert capabilities.
+type Service struct {
+	connectors *connector.Service
+}
+
+// New creates an upsert service instance.
+func New(connectors *connector.Service) *Service {
+	return &Service{connectors: connectors}
+}
+
+// Upsert inserts rows or updates existing ones on key conflict.
+func (s *Service) Upsert(ctx context.Context, input *Input) *Output {
+	output := &Output{Status: "ok"}
+	if input == nil {
+		input = &Input{}
+	}
+	if err := s.upsert(ctx, input, output); err != nil {
+		output.Status = "error"
+		output.Error = err.Error()
+	}
+	output.Connector = input.Connector
+	return output
+}
+
+func (s *Service) upsert(ctx context.Context, input *Input, output *Output) error {
+	if len(input.Rows) == 0 {
+		return fmt.Errorf("rows were empty")
+	}
+	con, err := s.connectors.Connection(ctx, input.Connector)
+	if err != nil {
+		return err
+	}
+	db, err := con.Db(ctx)
+	if err != nil {
+		return err
+	}
+	groups, err := groupRows(input)
+	if err != nil {
+		return err
+	}
+	batchSize := input.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	aDialect := dialect.Of(con.Driver)
+	return exec.Transact(ctx, db, con.Driver, func(ctx context.Context, executor exec.Executor) error {
+		for _, group := range groups {
+			updates := nonKeyColumns(group.columns, input.Keys)
+			if len(input.UpdateColumns) > 0 {
+				// only supplied columns are updated, a missing field never overwrites a value with NULL
+				updates = commonColumns(input.UpdateColumns, group.columns)
+			}
+			for offset := 0; offset < len(group.rows); offset += batchSize {
+				end := min(offset+batchSize, len(group.rows))
+				values := make([][]interface{}, 0, end-offset)
+				for _, row := range group.rows[offset:end] {
+					record := make([]interface{}, len(group.columns))
+					for i, column := range group.columns {
+						record[i], _ = field(row, column)
+					}
+					values = append(values, record)
+				}
+				stmt, err := Build(aDialect, input.Table, group.columns, input.Keys, updates, values)
+				if err != nil {
+					return err
+				}
+				result, err := executor.ExecContext(ctx, stmt.SQL, stmt.Args...)
+				if err != nil {
+					return err
+				}
+				affected, _ := result.RowsAffected()
+				output.RowsAffected += affected
+				output.Statements++
+			}
+		}
+		return nil
+	})
+}
+
+// rowGroup holds rows upserted with the same column list.
+type rowGroup struct {
+	columns []string
+	rows    []map[string]interface{}
+}
+
+// groupRows merges rows sharing a key (later fields win) so that no statement affects a row twice,
+// and groups them by field set, or into one group with input.Columns when the column list is fixed.
+func groupRows(input *Input) ([]*rowGroup, error) {
+	if len(input.Keys) == 0 {
+		return nil, fmt.Errorf("key columns were empty")
+	}
+	if len(input.Columns) > 0 {
+		if missing := missingColumns(input.Keys, input.Columns); len(missing) > 0 {
+			return nil, fmt.Errorf("key columns %v are not in columns", strings.Join(missing, ", "))
+		}
+	}
+	var merged []map[string]interface{}
+	byKey := map[string]map[string]interface{}{}
+	for i, row := range input.Rows {
+		parts := make([]string, len(input.Keys))
+		for j, key := range input.Keys {
+			value, ok := field(row, key)
+			if !ok {
+				return nil, fmt.Errorf("row %d has no key column %v", i+1, key)
+			}
+			parts[j] = fmt.Sprintf("%T=%v", value, value)
+		}
+		identity := strings.Join(parts, "\x00")
+		if existing, ok := byKey[identity]; ok {
+			for name, value := range row {
+				existing[name] = value
+			}
+			continue
+		}
+		record := make(map[string]interface{}, len(row))
+		for name, value := range row {
+			record[name] = value
+		}
+		byKey[identity] = record
+		merged = append(merged, record)
+	}
+	if len(input.Columns) > 0 {
+		return []*rowGroup{{columns: input.Columns, rows: merged}}, nil
+	}
+	var groups []*rowGroup
+	byColumns := map[string]*rowGroup{}
+	for _, row := range merged {
+		columns := rowColumns([]map[string]interface{}{row})
+		signature := strings.Join(columns, "\x00")
+		group, ok := byColumns[signature]
+		if !ok {
+			group = &rowGroup{columns: columns}
+			byColumns[signature] = group
+			groups = append(groups, group)
+		}
+		group.rows = append(group.rows, row)
+	}
+	return groups, nil
+}
+
+// field returns a row value by column name, falling back to a case-insensitive match.
+func field(row map[string]interface{}, name string) (interface{}, bool) {
+	if value, ok := row[name]; ok {
+		return value, true
+	}
+	for key, value := range row {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// commonColumns returns columns that are also listed in available, ignoring case.
+func commonColumns(columns, available []string) []string {
+	var result []string
+	for _, column := range columns {
+		if len(missingColumns([]string{column}, available)) == 0 {
+			result = append(result, column)
+		}
+	}
+	return result
+}
+
+// missingColumns returns names not listed in columns, ignoring case.
+func missingColumns(names, columns []string) []string {
+	var result []string
+	for _, name := range names {
+		found := false
+		for _, column := range columns {
+			if strings.EqualFold(name, column) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// rowColumns returns sorted union of all row field names.
+func rowColumns(rows []map[string]interface{}) []string {
+	unique := map[string]bool{}
+	for _, row := range rows {
+		for name := range row {
+			unique[name] = true
+		}
+	}
+	columns := make([]string, 0, len(unique))
+	for name := range unique {
+		columns = append(columns, name)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+func nonKeyColumns(columns, keys []string) []string {
+	var result []string
+	for _, column := range columns {
+		isKey := false
+		for _, key := range keys {
+			if strings.EqualFold(column, key) {
+				isKey = true
+				break
+			}
+		}
+		if !isKey {
+			result = append(result, column)
+		}
+	}
+	return result
+}
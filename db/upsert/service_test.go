@@ -0,0 +1,158 @@
+package upsert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite" // register SQLite driver
+
+	"github.com/viant/mcp-sqlkit/auth"
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/dialect"
+	"github.com/viant/mcp-sqlkit/policy"
+	"github.com/viant/scy"
+)
+
+func TestBuild(t *testing.T) {
+	type testCase struct {
+		name   string
+		driver string
+		expect string
+	}
+
+	testCases := []testCase{
+		{
+			name:   "mysql",
+			driver: "mysql",
+			expect: "INSERT INTO users (id, name) VALUES (?, ?), (?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name)",
+		},
+		{
+			name:   "postgres",
+			driver: "postgres",
+			expect: "INSERT INTO users (id, name) VALUES ($1, $2), ($3, $4) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name",
+		},
+		{
+			name:   "oracle",
+			driver: "oracle",
+			expect: "MERGE INTO users t USING (SELECT :1 AS id, :2 AS name FROM DUAL UNION ALL SELECT :3 AS id, :4 AS name FROM DUAL) s ON (t.id = s.id) WHEN MATCHED THEN UPDATE SET t.name = s.name WHEN NOT MATCHED THEN INSERT (id, name) VALUES (s.id, s.name)",
+		},
+		{
+			name:   "bigquery",
+			driver: "bigquery",
+			expect: "MERGE users t USING (SELECT ? AS id, ? AS name UNION ALL SELECT ? AS id, ? AS name) s ON (t.id = s.id) WHEN MATCHED THEN UPDATE SET name = s.name WHEN NOT MATCHED THEN INSERT (id, name) VALUES (s.id, s.name)",
+		},
+	}
+
+	rows := [][]interface{}{{1, "a"}, {2, "b"}}
+	for _, tc := range testCases {
+		stmt, err := Build(dialect.Of(tc.driver), "users", []string{"id", "name"}, []string{"id"}, []string{"name"}, rows)
+		require.NoError(t, err, tc.name)
+		assert.EqualValues(t, tc.expect, stmt.SQL, tc.name)
+		assert.EqualValues(t, []interface{}{1, "a", 2, "b"}, stmt.Args, tc.name)
+	}
+
+	_, err := Build(dialect.Of("unknown"), "users", []string{"id"}, []string{"id"}, nil, [][]interface{}{{1}})
+	assert.Error(t, err)
+}
+
+func TestService_Upsert(t *testing.T) {
+	cfg := &connector.Config{}
+	mgr := connector.NewManager(cfg, auth.New(&policy.Policy{}), scy.New())
+	connSvc := connector.NewService(mgr, nil)
+	service := New(connSvc)
+
+	ctx := context.Background()
+	conn := &connector.Connector{Name: "testConn", Driver: "sqlite", DSN: "file:upsertdb?mode=memory&cache=shared"}
+	pend, err := connSvc.GeneratePendingSecret(ctx, conn)
+	require.NoError(t, err)
+	pend.NS.Connectors.Put(conn.Name, conn)
+
+	db, err := conn.Db(ctx)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "CREATE TABLE countries(code TEXT PRIMARY KEY, name TEXT)")
+	require.NoError(t, err)
+
+	out := service.Upsert(ctx, &Input{
+		Connector: "testConn",
+		Table:     "countries",
+		Keys:      []string{"code"},
+		Rows:      []map[string]interface{}{{"code": "PL", "name": "Poland"}, {"code": "US", "name": "USA"}},
+	})
+	require.Equal(t, "ok", out.Status, out.Error)
+
+	out = service.Upsert(ctx, &Input{
+		Connector: "testConn",
+		Table:     "countries",
+		Keys:      []string{"code"},
+		Rows:      []map[string]interface{}{{"code": "US", "name": "United States"}, {"code": "CA", "name": "Canada"}},
+		BatchSize: 1,
+	})
+	require.Equal(t, "ok", out.Status, out.Error)
+	assert.EqualValues(t, 2, out.Statements)
+
+	var count int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM countries").Scan(&count))
+	assert.EqualValues(t, 3, count)
+	var name string
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT name FROM countries WHERE code = 'US'").Scan(&name))
+	assert.EqualValues(t, "United States", name)
+
+	// a row without name keeps the stored value; rows sharing a key are merged
+	out = service.Upsert(ctx, &Input{
+		Connector: "testConn",
+		Table:     "countries",
+		Keys:      []string{"code"},
+		Rows:      []map[string]interface{}{{"code": "CA"}, {"code": "US", "name": "U.S."}, {"code": "US", "name": "America"}},
+	})
+	require.Equal(t, "ok", out.Status, out.Error)
+	assert.EqualValues(t, 2, out.Statements)
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT name FROM countries WHERE code = 'CA'").Scan(&name))
+	assert.EqualValues(t, "Canada", name)
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT name FROM countries WHERE code = 'US'").Scan(&name))
+	assert.EqualValues(t, "America", name)
+}
+
+func TestGroupRows(t *testing.T) {
+	type testCase struct {
+		name   string
+		input  *Input
+		expect []*rowGroup
+		hasErr bool
+	}
+
+	testCases := []testCase{
+		{
+			name:  "by field set",
+			input: &Input{Keys: []string{"id"}, Rows: []map[string]interface{}{{"id": 1, "a": "x"}, {"id": 2}, {"id": 3, "a": "y"}}},
+			expect: []*rowGroup{
+				{columns: []string{"a", "id"}, rows: []map[string]interface{}{{"id": 1, "a": "x"}, {"id": 3, "a": "y"}}},
+				{columns: []string{"id"}, rows: []map[string]interface{}{{"id": 2}}},
+			},
+		},
+		{
+			name:   "merged key",
+			input:  &Input{Keys: []string{"ID"}, Rows: []map[string]interface{}{{"id": 1, "a": "x"}, {"id": 1, "b": "y"}}},
+			expect: []*rowGroup{{columns: []string{"a", "b", "id"}, rows: []map[string]interface{}{{"id": 1, "a": "x", "b": "y"}}}},
+		},
+		{
+			name:   "fixed columns",
+			input:  &Input{Keys: []string{"id"}, Columns: []string{"id", "a"}, Rows: []map[string]interface{}{{"id": 1}}},
+			expect: []*rowGroup{{columns: []string{"id", "a"}, rows: []map[string]interface{}{{"id": 1}}}},
+		},
+		{name: "missing key field", input: &Input{Keys: []string{"id"}, Rows: []map[string]interface{}{{"a": 1}}}, hasErr: true},
+		{name: "key not in columns", input: &Input{Keys: []string{"id"}, Columns: []string{"a"}, Rows: []map[string]interface{}{{"id": 1, "a": 1}}}, hasErr: true},
+		{name: "no keys", input: &Input{Rows: []map[string]interface{}{{"id": 1}}}, hasErr: true},
+	}
+
+	for _, tc := range testCases {
+		actual, err := groupRows(tc.input)
+		if tc.hasErr {
+			assert.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		assert.EqualValues(t, tc.expect, actual, tc.name)
+	}
+}
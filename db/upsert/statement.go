@@ -0,0 +1,149 @@
+package upsert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/viant/mcp-sqlkit/db/dialect"
+)
+
+// Statement represents a parameterized upsert statement.
+type Statement struct {
+	SQL  string
+	Args []interface{}
+}
+
+// Build renders a dialect specific upsert statement for the supplied rows:
+// MySQL uses ON DUPLICATE KEY UPDATE, Postgres and SQLite use
+// ON CONFLICT ... DO UPDATE, Oracle and BigQuery use MERGE.
+func Build(d *dialect.Dialect, table string, columns, keys, updates []string, rows [][]interface{}) (*Statement, error) {
+	if table == "" {
+		return nil, fmt.Errorf("table was empty")
+	}
+	if len(columns) == 0 || len(rows) == 0 {
+		return nil, fmt.Errorf("no rows to upsert into %s", table)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("key columns were empty")
+	}
+	b := &builder{dialect: d}
+	switch d.Driver {
+	case "mysql":
+		b.insertValues(table, columns, rows)
+		b.sql.WriteString(" ON DUPLICATE KEY UPDATE ")
+		if len(updates) == 0 {
+			// no-op assignment keeps the existing row untouched
+			updates = keys[:1]
+		}
+		for i, column := range updates {
+			if i > 0 {
+				b.sql.WriteString(", ")
+			}
+			name := d.QuoteIdentifier(column)
+			b.sql.WriteString(name + " = VALUES(" + name + ")")
+		}
+	case "postgres", "sqlite":
+		b.insertValues(table, columns, rows)
+		b.sql.WriteString(" ON CONFLICT (" + b.list(keys, "") + ")")
+		if len(updates) == 0 {
+			b.sql.WriteString(" DO NOTHING")
+			break
+		}
+		b.sql.WriteString(" DO UPDATE SET ")
+		for i, column := range updates {
+			if i > 0 {
+				b.sql.WriteString(", ")
+			}
+			name := d.QuoteIdentifier(column)
+			b.sql.WriteString(name + " = EXCLUDED." + name)
+		}
+	case "oracle", "bigquery":
+		b.merge(table, columns, keys, updates, rows)
+	default:
+		return nil, fmt.Errorf("upsert is not supported for driver: %s", d.Driver)
+	}
+	return &Statement{SQL: b.sql.String(), Args: b.args}, nil
+}
+
+type builder struct {
+	dialect *dialect.Dialect
+	sql     strings.Builder
+	args    []interface{}
+}
+
+func (b *builder) bind(value interface{}) string {
+	b.args = append(b.args, value)
+	return b.dialect.Placeholder(len(b.args))
+}
+
+func (b *builder) list(columns []string, prefix string) string {
+	items := make([]string, len(columns))
+	for i, column := range columns {
+		items[i] = prefix + b.dialect.QuoteIdentifier(column)
+	}
+	return strings.Join(items, ", ")
+}
+
+func (b *builder) insertValues(table string, columns []string, rows [][]interface{}) {
+	b.sql.WriteString("INSERT INTO " + b.dialect.QuoteIdentifier(table) + " (" + b.list(columns, "") + ") VALUES ")
+	for i, row := range rows {
+		if i > 0 {
+			b.sql.WriteString(", ")
+		}
+		b.sql.WriteString("(")
+		for j, value := range row {
+			if j > 0 {
+				b.sql.WriteString(", ")
+			}
+			b.sql.WriteString(b.bind(value))
+		}
+		b.sql.WriteString(")")
+	}
+}
+
+func (b *builder) merge(table string, columns, keys, updates []string, rows [][]interface{}) {
+	isOracle := b.dialect.Driver == "oracle"
+	b.sql.WriteString("MERGE ")
+	if isOracle {
+		b.sql.WriteString("INTO ")
+	}
+	b.sql.WriteString(b.dialect.QuoteIdentifier(table) + " t USING (")
+	for i, row := range rows {
+		if i > 0 {
+			b.sql.WriteString(" UNION ALL ")
+		}
+		b.sql.WriteString("SELECT ")
+		for j, value := range row {
+			if j > 0 {
+				b.sql.WriteString(", ")
+			}
+			b.sql.WriteString(b.bind(value) + " AS " + b.dialect.QuoteIdentifier(columns[j]))
+		}
+		if isOracle {
+			b.sql.WriteString(" FROM DUAL")
+		}
+	}
+	b.sql.WriteString(") s ON (")
+	for i, key := range keys {
+		if i > 0 {
+			b.sql.WriteString(" AND ")
+		}
+		name := b.dialect.QuoteIdentifier(key)
+		b.sql.WriteString("t." + name + " = s." + name)
+	}
+	b.sql.WriteString(")")
+	if len(updates) > 0 {
+		b.sql.WriteString(" WHEN MATCHED THEN UPDATE SET ")
+		for i, column := range updates {
+			if i > 0 {
+				b.sql.WriteString(", ")
+			}
+			name := b.dialect.QuoteIdentifier(column)
+			if isOracle {
+				b.sql.WriteString("t.")
+			}
+			b.sql.WriteString(name + " = s." + name)
+		}
+	}
+	b.sql.WriteString(" WHEN NOT MATCHED THEN INSERT (" + b.list(columns, "") + ") VALUES (" + b.list(columns, "s.") + ")")
+}
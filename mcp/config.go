@@ -3,8 +3,10 @@ package mcp
 import (
 	"fmt"
 	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/meta"
 	"github.com/viant/mcp-sqlkit/policy"
 	"strings"
+	"time"
 )
 
 type Config struct {
@@ -20,6 +22,27 @@ type Config struct {
 	// used `useText` (default false).  When both UseText and UseData are set
 	// the latter wins.
 	UseText bool `json:"useText,omitempty"`
+
+	// MetadataCacheTTLSeconds controls how long catalog metadata (tables,
+	// columns, keys) is cached per connector; 0 uses the default of 5 minutes,
+	// a negative value disables caching.
+	MetadataCacheTTLSeconds int `json:"metadataCacheTTLSeconds,omitempty"`
+
+	// AnnotationsURL is the base afs location of table/column annotation files
+	// (<annotationsURL>/<namespace>/<connector>.json); empty uses
+	// mcp-sqlkit/annotations under the user configuration directory.
+	AnnotationsURL string `json:"annotationsURL,omitempty"`
+}
+
+// MetadataCacheTTL returns effective metadata cache TTL.
+func (c *Config) MetadataCacheTTL() time.Duration {
+	switch {
+	case c.MetadataCacheTTLSeconds < 0:
+		return 0
+	case c.MetadataCacheTTLSeconds == 0:
+		return meta.DefaultCacheTTL
+	}
+	return time.Duration(c.MetadataCacheTTLSeconds) * time.Second
 }
 
 func (c *Config) Init(httpAddr string) {
@@ -5,11 +5,16 @@ import (
 	"github.com/viant/jsonrpc/transport"
 	protoclient "github.com/viant/mcp-protocol/client"
 	"github.com/viant/mcp-protocol/logger"
+	"github.com/viant/mcp-protocol/schema"
 	protoserver "github.com/viant/mcp-protocol/server"
+	"github.com/viant/mcp-sqlkit/db/call"
 	"github.com/viant/mcp-sqlkit/db/connector"
 	"github.com/viant/mcp-sqlkit/db/exec"
+	"github.com/viant/mcp-sqlkit/db/load"
 	"github.com/viant/mcp-sqlkit/db/meta"
+	"github.com/viant/mcp-sqlkit/db/migrate"
 	"github.com/viant/mcp-sqlkit/db/query"
+	"github.com/viant/mcp-sqlkit/db/upsert"
 )
 
 type Handler struct {
@@ -18,6 +23,10 @@ type Handler struct {
 	exec       *exec.Service
 	query      *query.Service
 	meta       *meta.Service
+	upsert     *upsert.Service
+	load       *load.Service
+	migrate    *migrate.Service
+	call       *call.Service
 	connectors *connector.Service
 }
 
@@ -30,12 +39,21 @@ func NewHandler(service *Service) protoserver.NewHandler {
 			query:          service.NewQueryService(clientOperation),
 			exec:           service.NewExecService(clientOperation),
 			meta:           service.NewMetaService(clientOperation),
+			upsert:         service.NewUpsertService(clientOperation),
+			load:           service.NewLoadService(clientOperation),
+			migrate:        service.NewMigrateService(clientOperation),
+			call:           service.NewCallService(clientOperation),
 			connectors:     service.NewConnector(clientOperation),
 		}
 		err := registerTools(base, ret)
 		if err != nil {
 			return nil, err
 		}
+		// table resources are resolved per namespace on demand (see resource.go)
+		base.Methods.Put(schema.MethodResourcesList, true)
+		base.Methods.Put(schema.MethodResourcesRead, true)
+		base.Methods.Put(schema.MethodSubscribe, true)
+		base.Methods.Put(schema.MethodUnsubscribe, true)
 		return ret, nil
 	}
 }
@@ -0,0 +1,23 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewHandler_RegistersTools verifies that every tool derives a valid
+// schema and is registered on handler construction.
+func TestNewHandler_RegistersTools(t *testing.T) {
+	svc := NewService(&Config{})
+	handler, err := NewHandler(svc)(context.Background(), nil, nil, nil)
+	require.NoError(t, err)
+
+	registry := handler.(*Handler).Registry
+	for _, name := range []string{"dbQuery", "dbExec", "dbUpsert", "dbLoad", "dbMigrate", "dbCall", "dbListConnections", "dbSetConnection", "dbRemoveConnection", "dbTestConnection", "dbPoolStats", "dbListSchemas", "dbListTables", "dbListColumns", "dbDescribeTable", "dbShowCreate", "dbDiagram", "dbSearchSchema", "dbSchemaDiff", "dbTableStats", "dbListViews", "dbListRoutines", "dbListSequences", "dbInfo", "dbAnnotate", "dbDataDictionary", "dbSchemaDigest"} {
+		_, ok := registry.ToolRegistry.Get(name)
+		assert.True(t, ok, name)
+	}
+}
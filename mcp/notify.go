@@ -0,0 +1,137 @@
+package mcp
+
+import (
+	"context"
+	"log"
+	"sync"
+	"weak"
+
+	"github.com/viant/jsonrpc"
+	"github.com/viant/mcp-protocol/schema"
+)
+
+// methodNotificationResourcesListChanged is not defined by mcp-protocol schema constants.
+const methodNotificationResourcesListChanged = "notifications/resources/list_changed"
+
+// resourceNotifier fans out connector and schema change events to MCP sessions
+// that listed or subscribed to resources in the affected namespace. Sessions are
+// held by weak pointers: transports do not report closed sessions, so a handler
+// is dropped once its session is released and garbage collected.
+type resourceNotifier struct {
+	mux      sync.RWMutex
+	sessions map[weak.Pointer[Handler]]string
+}
+
+func newResourceNotifier() *resourceNotifier {
+	return &resourceNotifier{sessions: map[weak.Pointer[Handler]]string{}}
+}
+
+// watch registers a session for resource notifications of namespace.
+func (n *resourceNotifier) watch(handler *Handler, namespace string) {
+	if handler.Notifier == nil {
+		return
+	}
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	n.sessions[weak.Make(handler)] = namespace
+}
+
+// unwatch removes a session from resource notifications.
+func (n *resourceNotifier) unwatch(handler *Handler) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	delete(n.sessions, weak.Make(handler))
+}
+
+// size returns the number of watched sessions.
+func (n *resourceNotifier) size() int {
+	n.mux.RLock()
+	defer n.mux.RUnlock()
+	return len(n.sessions)
+}
+
+// changed sends list_changed and resource updates for subscribed URIs of the connector.
+func (n *resourceNotifier) changed(namespace, connector string) {
+	n.mux.Lock()
+	var handlers []*Handler
+	for session, ns := range n.sessions {
+		handler := session.Value()
+		if handler == nil {
+			delete(n.sessions, session)
+			continue
+		}
+		if ns == namespace {
+			handlers = append(handlers, handler)
+		}
+	}
+	n.mux.Unlock()
+	for _, handler := range handlers {
+		if err := handler.notifyResources(connector); err != nil {
+			// the session is gone once its transport rejects notifications
+			log.Printf("mcp-sqlkit resources notify error namespace=%q connector=%q err=%v", namespace, connector, err)
+			n.unwatch(handler)
+		}
+	}
+}
+
+// watchResources registers the handler session for resource change notifications.
+func (h *Handler) watchResources(ctx context.Context) {
+	namespace, err := h.connectors.Namespace(ctx)
+	if err != nil {
+		return
+	}
+	h.service.resources.watch(h, namespace)
+}
+
+// notifyResources notifies the client that the resource list changed and that
+// subscribed tables of the connector were updated.
+func (h *Handler) notifyResources(connector string) error {
+	var uris []string
+	h.Subscription.Range(func(URI string, _ bool) bool {
+		if resource, err := parseTableResource(URI); err == nil && resource.connector == connector {
+			uris = append(uris, URI)
+		}
+		return true
+	})
+	for _, URI := range uris {
+		if err := h.notify(schema.MethodNotificationResourceUpdated, &schema.ResourceUpdatedNotificationParams{Uri: URI}); err != nil {
+			return err
+		}
+	}
+	return h.notify(methodNotificationResourcesListChanged, map[string]interface{}{})
+}
+
+func (h *Handler) notify(method string, params interface{}) error {
+	notification, err := jsonrpc.NewNotification(method, params)
+	if err != nil {
+		return err
+	}
+	return h.Notifier.Notify(context.Background(), notification)
+}
+
+// Subscribe registers a resource URI for update notifications.
+func (h *Handler) Subscribe(ctx context.Context, jRequest *jsonrpc.TypedRequest[*schema.SubscribeRequest]) (*schema.SubscribeResult, *jsonrpc.Error) {
+	if _, err := parseTableResource(jRequest.Request.Params.Uri); err != nil {
+		return nil, jsonrpc.NewInvalidParamsError(err.Error(), nil)
+	}
+	h.watchResources(ctx)
+	return h.DefaultHandler.Subscribe(ctx, jRequest)
+}
+
+// Unsubscribe removes a resource URI; the session stops receiving notifications
+// once no subscriptions remain (a later resources/list registers it again).
+func (h *Handler) Unsubscribe(ctx context.Context, jRequest *jsonrpc.TypedRequest[*schema.UnsubscribeRequest]) (*schema.UnsubscribeResult, *jsonrpc.Error) {
+	result, rpcErr := h.DefaultHandler.Unsubscribe(ctx, jRequest)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	subscribed := false
+	h.Subscription.Range(func(string, bool) bool {
+		subscribed = true
+		return false
+	})
+	if !subscribed {
+		h.service.resources.unwatch(h)
+	}
+	return result, nil
+}
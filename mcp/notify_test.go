@@ -0,0 +1,158 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/viant/jsonrpc"
+	"github.com/viant/mcp-protocol/schema"
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/exec"
+)
+
+type recordingNotifier struct {
+	mux           sync.Mutex
+	notifications []string
+}
+
+func (r *recordingNotifier) Notify(_ context.Context, notification *jsonrpc.Notification) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	entry := notification.Method
+	if notification.Method == schema.MethodNotificationResourceUpdated {
+		params := &schema.ResourceUpdatedNotificationParams{}
+		if err := json.Unmarshal(notification.Params, params); err != nil {
+			return err
+		}
+		entry += " " + params.Uri
+	}
+	r.notifications = append(r.notifications, entry)
+	return nil
+}
+
+func (r *recordingNotifier) take() []string {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	ret := r.notifications
+	r.notifications = nil
+	return ret
+}
+
+func TestHandler_ResourceNotifications(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(&Config{})
+	notifier := &recordingNotifier{}
+	instance, err := NewHandler(svc)(ctx, notifier, nil, nil)
+	require.NoError(t, err)
+	handler := instance.(*Handler)
+	// a session that never listed or subscribed to resources is not notified
+	idle := &recordingNotifier{}
+	_, err = NewHandler(svc)(ctx, idle, nil, nil)
+	require.NoError(t, err)
+
+	conn := &connector.Connector{Name: "notified", Driver: "sqlite", DSN: "file:notified?mode=memory&cache=shared"}
+	pend, err := handler.connectors.GeneratePendingSecret(ctx, conn)
+	require.NoError(t, err)
+	pend.NS.Connectors.Put(conn.Name, conn)
+	db, err := conn.Db(ctx)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "CREATE TABLE orders(id INTEGER PRIMARY KEY)")
+	require.NoError(t, err)
+
+	subscribe := func(uri string) *jsonrpc.Error {
+		_, rpcErr := handler.Subscribe(ctx, &jsonrpc.TypedRequest[*schema.SubscribeRequest]{Request: &schema.SubscribeRequest{Params: schema.SubscribeRequestParams{Uri: uri}}})
+		return rpcErr
+	}
+	assert.NotNil(t, subscribe("sqlkit://notified/orders"))
+	require.Nil(t, subscribe("sqlkit://notified/-/orders"))
+	require.Nil(t, subscribe("sqlkit://other/-/orders"))
+
+	type testCase struct {
+		name   string
+		change func()
+		expect []string
+	}
+	testCases := []testCase{
+		{
+			name: "dml",
+			change: func() {
+				out := handler.exec.Execute(ctx, &exec.Input{Connector: "notified", Query: "INSERT INTO orders(id) VALUES (1)"})
+				require.Equal(t, "ok", out.Status, out.Error)
+			},
+		},
+		{
+			name: "ddl",
+			change: func() {
+				out := handler.exec.Execute(ctx, &exec.Input{Connector: "notified", Query: "ALTER TABLE orders ADD COLUMN note TEXT"})
+				require.Equal(t, "ok", out.Status, out.Error)
+			},
+			expect: []string{"notifications/resources/updated sqlkit://notified/-/orders", "notifications/resources/list_changed"},
+		},
+		{
+			name: "set",
+			change: func() {
+				_, err := handler.connectors.Set(ctx, &connector.Connector{Name: "other", Driver: "sqlite", DSN: "file:other?mode=memory&cache=shared"})
+				require.NoError(t, err)
+			},
+			expect: []string{"notifications/resources/updated sqlkit://other/-/orders", "notifications/resources/list_changed"},
+		},
+		{
+			name:   "remove",
+			change: func() { handler.connectors.Remove(ctx, "notified") },
+			expect: []string{"notifications/resources/updated sqlkit://notified/-/orders", "notifications/resources/list_changed"},
+		},
+		{
+			name:   "remove missing",
+			change: func() { handler.connectors.Remove(ctx, "notified") },
+		},
+	}
+	for _, tc := range testCases {
+		notifier.take()
+		tc.change()
+		assert.EqualValues(t, tc.expect, notifier.take(), tc.name)
+	}
+	assert.Empty(t, idle.take())
+}
+
+func TestResourceNotifier_Sessions(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(&Config{})
+	unsubscribe := func(handler *Handler, uri string) {
+		_, rpcErr := handler.Unsubscribe(ctx, &jsonrpc.TypedRequest[*schema.UnsubscribeRequest]{Request: &schema.UnsubscribeRequest{Params: schema.UnsubscribeRequestParams{Uri: uri}}})
+		require.Nil(t, rpcErr)
+	}
+	subscribe := func(handler *Handler, uri string) {
+		_, rpcErr := handler.Subscribe(ctx, &jsonrpc.TypedRequest[*schema.SubscribeRequest]{Request: &schema.SubscribeRequest{Params: schema.SubscribeRequestParams{Uri: uri}}})
+		require.Nil(t, rpcErr)
+	}
+	newHandler := func() *Handler {
+		instance, err := NewHandler(svc)(ctx, &recordingNotifier{}, nil, nil)
+		require.NoError(t, err)
+		return instance.(*Handler)
+	}
+
+	handler := newHandler()
+	subscribe(handler, "sqlkit://dev/-/orders")
+	subscribe(handler, "sqlkit://dev/-/customers")
+	assert.Equal(t, 1, svc.resources.size())
+	unsubscribe(handler, "sqlkit://dev/-/orders")
+	assert.Equal(t, 1, svc.resources.size(), "remaining subscription keeps the session")
+	unsubscribe(handler, "sqlkit://dev/-/customers")
+	assert.Equal(t, 0, svc.resources.size(), "session without subscriptions is released")
+
+	// a closed session is released by its transport; the notifier must not keep it alive
+	func() {
+		subscribe(newHandler(), "sqlkit://dev/-/orders")
+	}()
+	assert.Equal(t, 1, svc.resources.size())
+	for i := 0; i < 10 && svc.resources.size() > 0; i++ {
+		runtime.GC()
+		svc.resources.changed("default", "dev")
+	}
+	assert.Equal(t, 0, svc.resources.size())
+}
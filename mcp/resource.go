@@ -0,0 +1,216 @@
+package mcp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/viant/jsonrpc"
+	"github.com/viant/mcp-protocol/schema"
+	"github.com/viant/mcp-sqlkit/db/meta"
+)
+
+const (
+	// resourceScheme prefixes table resource URIs: sqlkit://{connector}/{schema}/{table}.
+	resourceScheme = "sqlkit://"
+	// defaultSchemaSegment stands for the connector default schema when a driver reports none.
+	defaultSchemaSegment = "-"
+
+	mimeJSON     = "application/json"
+	mimeMarkdown = "text/markdown"
+)
+
+// resourcePageSize caps table resources returned by one resources/list call; further
+// pages are addressed by nextCursor.
+var resourcePageSize = 200
+
+// tableTemplate addresses any table of a visible connector.
+var tableTemplate = schema.ResourceTemplate{
+	Name:        "table",
+	UriTemplate: resourceScheme + "{connector}/{schema}/{table}{?format}",
+	Title:       ptr("Database table"),
+	Description: ptr("Columns, primary, unique and foreign keys and indexes of a table; format=markdown renders Markdown instead of JSON. Use - as schema for the connector default."),
+	MimeType:    ptr(mimeJSON),
+}
+
+// tableResource identifies a table addressed by a resource URI.
+type tableResource struct {
+	connector string
+	schema    string
+	table     string
+	format    string
+}
+
+// URI returns the canonical resource URI.
+func (r *tableResource) URI() string {
+	schemaName := r.schema
+	if schemaName == "" {
+		schemaName = defaultSchemaSegment
+	}
+	URI := resourceScheme + url.PathEscape(r.connector) + "/" + url.PathEscape(schemaName) + "/" + url.PathEscape(r.table)
+	if r.format == "markdown" {
+		URI += "?format=markdown"
+	}
+	return URI
+}
+
+// parseTableResource parses sqlkit://{connector}/{schema}/{table}[?format=json|markdown].
+func parseTableResource(URI string) (*tableResource, error) {
+	if !strings.HasPrefix(URI, resourceScheme) {
+		return nil, fmt.Errorf("unsupported resource URI: %v", URI)
+	}
+	location, query, _ := strings.Cut(strings.TrimPrefix(URI, resourceScheme), "?")
+	segments := strings.Split(location, "/")
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("invalid resource URI: %v, expected %v{connector}/{schema}/{table}", URI, resourceScheme)
+	}
+	for i, segment := range segments {
+		value, err := url.PathUnescape(segment)
+		if err != nil || value == "" {
+			return nil, fmt.Errorf("invalid resource URI: %v", URI)
+		}
+		segments[i] = value
+	}
+	ret := &tableResource{connector: segments[0], schema: segments[1], table: segments[2], format: "json"}
+	if ret.schema == defaultSchemaSegment {
+		ret.schema = ""
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource URI query: %w", err)
+	}
+	switch format := strings.ToLower(values.Get("format")); format {
+	case "", "json":
+	case "markdown", "md":
+		ret.format = "markdown"
+	default:
+		return nil, fmt.Errorf("unsupported resource format: %v", format)
+	}
+	return ret, nil
+}
+
+// Initialize advertises resource capability, subscriptions and list change notifications
+// in addition to the registered tools.
+func (h *Handler) Initialize(ctx context.Context, init *schema.InitializeRequestParams, result *schema.InitializeResult) {
+	h.DefaultHandler.Initialize(ctx, init, result)
+	result.Capabilities.Resources = &schema.ServerCapabilitiesResources{Subscribe: ptr(true), ListChanged: ptr(true)}
+}
+
+// ListResources exposes tables and views of every connector visible in the caller namespace,
+// one page at a time; connectors are listed in name order and only those needed for the page are read.
+func (h *Handler) ListResources(ctx context.Context, jRequest *jsonrpc.TypedRequest[*schema.ListResourcesRequest]) (*schema.ListResourcesResult, *jsonrpc.Error) {
+	result := &schema.ListResourcesResult{Resources: []schema.Resource{}}
+	h.watchResources(ctx)
+	position := &resourceCursor{}
+	if request := jRequest.Request; request != nil && request.Params != nil && request.Params.Cursor != nil {
+		var err error
+		if position, err = parseResourceCursor(*request.Params.Cursor); err != nil {
+			return nil, jsonrpc.NewInvalidParamsError(err.Error(), nil)
+		}
+	}
+	connectors := h.connectors.List(ctx)
+	sort.Slice(connectors, func(i, j int) bool { return connectors[i].Name < connectors[j].Name })
+	for _, conn := range connectors {
+		if conn.Name < position.connector {
+			continue
+		}
+		offset := 0
+		if conn.Name == position.connector {
+			offset = position.offset
+		}
+		page := meta.Page{Limit: resourcePageSize - len(result.Resources), Offset: offset}
+		out := h.meta.ListTables(ctx, &meta.ListTablesInput{Connector: conn.Name, Page: page})
+		if out.Status == "error" {
+			log.Printf("mcp-sqlkit resources/list skip connector=%q err=%v", conn.Name, out.Error)
+			continue
+		}
+		for _, table := range out.Data {
+			resource := &tableResource{connector: conn.Name, schema: table.Schema, table: table.Name}
+			description := fmt.Sprintf("Columns and keys of %v on connector %v", table.Name, conn.Name)
+			if table.Comment != nil && *table.Comment != "" {
+				description += ": " + *table.Comment
+			}
+			name := table.Name
+			if table.Schema != "" {
+				name = table.Schema + "." + table.Name
+			}
+			result.Resources = append(result.Resources, schema.Resource{
+				Uri:         resource.URI(),
+				Name:        conn.Name + "/" + name,
+				Title:       ptr(name),
+				Description: ptr(description),
+				MimeType:    ptr(mimeJSON),
+			})
+		}
+		if len(result.Resources) >= resourcePageSize {
+			next := &resourceCursor{connector: conn.Name, offset: offset + len(out.Data)}
+			result.NextCursor = ptr(next.String())
+			break
+		}
+	}
+	return result, nil
+}
+
+// resourceCursor is the resources/list position: connector name and table offset within it.
+type resourceCursor struct {
+	connector string
+	offset    int
+}
+
+// String returns the opaque cursor token.
+func (c *resourceCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.connector + "\n" + strconv.Itoa(c.offset)))
+}
+
+func parseResourceCursor(token string) (*resourceCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %v", token)
+	}
+	connector, offset, ok := strings.Cut(string(data), "\n")
+	ret := &resourceCursor{connector: connector}
+	if ret.offset, err = strconv.Atoi(offset); !ok || err != nil || ret.offset < 0 {
+		return nil, fmt.Errorf("invalid cursor: %v", token)
+	}
+	return ret, nil
+}
+
+// ListResourceTemplates returns the table resource template.
+func (h *Handler) ListResourceTemplates(_ context.Context, _ *jsonrpc.TypedRequest[*schema.ListResourceTemplatesRequest]) (*schema.ListResourceTemplatesResult, *jsonrpc.Error) {
+	return &schema.ListResourceTemplatesResult{ResourceTemplates: []schema.ResourceTemplate{tableTemplate}}, nil
+}
+
+// ReadResource returns the table description as JSON or Markdown.
+func (h *Handler) ReadResource(ctx context.Context, jRequest *jsonrpc.TypedRequest[*schema.ReadResourceRequest]) (*schema.ReadResourceResult, *jsonrpc.Error) {
+	URI := jRequest.Request.Params.Uri
+	resource, err := parseTableResource(URI)
+	if err != nil {
+		return nil, jsonrpc.NewInvalidParamsError(err.Error(), nil)
+	}
+	out := h.meta.DescribeTable(ctx, &meta.DescribeTableInput{Connector: resource.connector, Schema: resource.schema, Table: resource.table})
+	if out.Status == "error" {
+		return nil, jsonrpc.NewInvalidParamsError(out.Error, nil)
+	}
+	content := schema.ReadResourceResultContentsElem{Uri: URI, MimeType: ptr(mimeJSON)}
+	if resource.format == "markdown" {
+		content.MimeType = ptr(mimeMarkdown)
+		content.Text = out.Data.Markdown()
+	} else {
+		data, err := json.Marshal(out.Data)
+		if err != nil {
+			return nil, jsonrpc.NewInternalError(err.Error(), nil)
+		}
+		content.Text = string(data)
+	}
+	return &schema.ReadResourceResult{Contents: []schema.ReadResourceResultContentsElem{content}}, nil
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
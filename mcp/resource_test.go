@@ -0,0 +1,136 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/viant/jsonrpc"
+	"github.com/viant/mcp-protocol/schema"
+	"github.com/viant/mcp-sqlkit/db/connector"
+	"github.com/viant/mcp-sqlkit/db/meta"
+)
+
+func TestParseTableResource(t *testing.T) {
+	type testCase struct {
+		name   string
+		uri    string
+		expect *tableResource
+		hasErr bool
+	}
+	testCases := []testCase{
+		{name: "json", uri: "sqlkit://dev/public/users", expect: &tableResource{connector: "dev", schema: "public", table: "users", format: "json"}},
+		{name: "markdown", uri: "sqlkit://dev/public/users?format=markdown", expect: &tableResource{connector: "dev", schema: "public", table: "users", format: "markdown"}},
+		{name: "default schema", uri: "sqlkit://dev/-/users", expect: &tableResource{connector: "dev", table: "users", format: "json"}},
+		{name: "escaped", uri: "sqlkit://dev/my%20db/order%2Fitems", expect: &tableResource{connector: "dev", schema: "my db", table: "order/items", format: "json"}},
+		{name: "scheme", uri: "file:///tmp/users", hasErr: true},
+		{name: "segments", uri: "sqlkit://dev/users", hasErr: true},
+		{name: "format", uri: "sqlkit://dev/public/users?format=xml", hasErr: true},
+	}
+	for _, tc := range testCases {
+		actual, err := parseTableResource(tc.uri)
+		if tc.hasErr {
+			assert.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		assert.EqualValues(t, tc.expect, actual, tc.name)
+		reparsed, err := parseTableResource(actual.URI())
+		require.NoError(t, err, tc.name)
+		assert.EqualValues(t, tc.expect, reparsed, tc.name)
+	}
+}
+
+func TestHandler_Resources(t *testing.T) {
+	svc := NewService(&Config{})
+	instance, err := NewHandler(svc)(context.Background(), nil, nil, nil)
+	require.NoError(t, err)
+	handler := instance.(*Handler)
+	assert.True(t, handler.Implements(schema.MethodResourcesList))
+	assert.True(t, handler.Implements(schema.MethodResourcesRead))
+
+	ctx := context.Background()
+	conn := &connector.Connector{Name: "resources", Driver: "sqlite", DSN: "file:resources?mode=memory&cache=shared"}
+	pend, err := handler.connectors.GeneratePendingSecret(ctx, conn)
+	require.NoError(t, err)
+	pend.NS.Connectors.Put(conn.Name, conn)
+	db, err := conn.Db(ctx)
+	require.NoError(t, err)
+	for _, stmt := range []string{
+		"CREATE TABLE customers(id INTEGER PRIMARY KEY, name TEXT NOT NULL)",
+		"CREATE TABLE orders(id INTEGER PRIMARY KEY, customer_id INTEGER REFERENCES customers(id), note TEXT)",
+	} {
+		_, err = db.ExecContext(ctx, stmt)
+		require.NoError(t, err)
+	}
+
+	listed, rpcErr := handler.ListResources(ctx, &jsonrpc.TypedRequest[*schema.ListResourcesRequest]{Request: &schema.ListResourcesRequest{}})
+	require.Nil(t, rpcErr)
+	var uris []string
+	for _, resource := range listed.Resources {
+		uris = append(uris, resource.Uri)
+	}
+	assert.ElementsMatch(t, []string{"sqlkit://resources/-/customers", "sqlkit://resources/-/orders"}, uris)
+	assert.Nil(t, listed.NextCursor)
+
+	// paging walks all tables one page at a time
+	pageSize := resourcePageSize
+	resourcePageSize = 1
+	var paged []string
+	var cursor *string
+	for i := 0; i < 5; i++ {
+		page, rpcErr := handler.ListResources(ctx, &jsonrpc.TypedRequest[*schema.ListResourcesRequest]{Request: &schema.ListResourcesRequest{Params: &schema.ListResourcesRequestParams{Cursor: cursor}}})
+		require.Nil(t, rpcErr)
+		assert.LessOrEqual(t, len(page.Resources), 1)
+		for _, resource := range page.Resources {
+			paged = append(paged, resource.Uri)
+		}
+		if cursor = page.NextCursor; cursor == nil {
+			break
+		}
+	}
+	resourcePageSize = pageSize
+	assert.Nil(t, cursor)
+	assert.ElementsMatch(t, uris, paged)
+	_, rpcErr = handler.ListResources(ctx, &jsonrpc.TypedRequest[*schema.ListResourcesRequest]{Request: &schema.ListResourcesRequest{Params: &schema.ListResourcesRequestParams{Cursor: ptr("not a cursor")}}})
+	assert.NotNil(t, rpcErr)
+
+	templates, rpcErr := handler.ListResourceTemplates(ctx, &jsonrpc.TypedRequest[*schema.ListResourceTemplatesRequest]{Request: &schema.ListResourceTemplatesRequest{}})
+	require.Nil(t, rpcErr)
+	require.Len(t, templates.ResourceTemplates, 1)
+	assert.Equal(t, "sqlkit://{connector}/{schema}/{table}{?format}", templates.ResourceTemplates[0].UriTemplate)
+
+	read := func(uri string) (*schema.ReadResourceResult, *jsonrpc.Error) {
+		return handler.ReadResource(ctx, &jsonrpc.TypedRequest[*schema.ReadResourceRequest]{Request: &schema.ReadResourceRequest{Params: schema.ReadResourceRequestParams{Uri: uri}}})
+	}
+	result, rpcErr := read("sqlkit://resources/-/orders")
+	require.Nil(t, rpcErr)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "application/json", *result.Contents[0].MimeType)
+	description := &meta.TableDescription{}
+	require.NoError(t, json.Unmarshal([]byte(result.Contents[0].Text), description))
+	assert.Equal(t, "orders", description.Table)
+	assert.Equal(t, []string{"id"}, description.PrimaryKey)
+	require.Len(t, description.ForeignKeys, 1)
+	assert.Equal(t, "customers", description.ForeignKeys[0].RefTable)
+
+	result, rpcErr = read("sqlkit://resources/-/customers?format=markdown")
+	require.Nil(t, rpcErr)
+	assert.Equal(t, "text/markdown", *result.Contents[0].MimeType)
+	assert.Contains(t, result.Contents[0].Text, "# main.customers")
+	assert.Contains(t, result.Contents[0].Text, "| name | TEXT | NO |")
+	assert.Contains(t, result.Contents[0].Text, "Primary key: id")
+
+	annotated := handler.meta.Annotate(ctx, &meta.AnnotateInput{Connector: "resources", Table: "customers", Column: "name", Description: "Legal name", PII: ptr(true)})
+	require.Equal(t, "ok", annotated.Status, annotated.Error)
+	result, rpcErr = read("sqlkit://resources/-/customers?format=markdown")
+	require.Nil(t, rpcErr)
+	assert.Contains(t, result.Contents[0].Text, "### name\n\nLegal name\n\n- PII: yes\n")
+
+	_, rpcErr = read("sqlkit://resources/-/missing")
+	assert.NotNil(t, rpcErr)
+	_, rpcErr = read("sqlkit://resources/customers")
+	assert.NotNil(t, rpcErr)
+}
@@ -5,19 +5,26 @@ import (
 
 	"github.com/viant/mcp-protocol/client"
 	"github.com/viant/mcp-sqlkit/auth"
+	"github.com/viant/mcp-sqlkit/db/call"
 	"github.com/viant/mcp-sqlkit/db/connector"
 	"github.com/viant/mcp-sqlkit/db/exec"
+	"github.com/viant/mcp-sqlkit/db/load"
 	"github.com/viant/mcp-sqlkit/db/meta"
+	"github.com/viant/mcp-sqlkit/db/migrate"
 	"github.com/viant/mcp-sqlkit/db/query"
+	"github.com/viant/mcp-sqlkit/db/upsert"
 	"github.com/viant/mcp-sqlkit/mcp/ui/interaction"
 	"github.com/viant/mcp-sqlkit/policy"
 	"github.com/viant/scy"
 )
 
 type Service struct {
-	connectors *connector.Manager
-	ui         *interaction.Service
-	auth       *auth.Service
+	connectors  *connector.Manager
+	ui          *interaction.Service
+	auth        *auth.Service
+	metaCache   *meta.Cache
+	annotations *meta.AnnotationStore
+	resources   *resourceNotifier
 
 	// useText determines which field (`text` vs `data`) the toolbox will
 	// populate when returning CallToolResultContentElem.
@@ -39,8 +46,24 @@ func (s *Service) NewExecService(operations client.Operations) *exec.Service {
 	return exec.New(s.NewConnector(operations))
 }
 
+func (s *Service) NewUpsertService(operations client.Operations) *upsert.Service {
+	return upsert.New(s.NewConnector(operations))
+}
+
+func (s *Service) NewLoadService(operations client.Operations) *load.Service {
+	return load.New(s.NewConnector(operations))
+}
+
+func (s *Service) NewMigrateService(operations client.Operations) *migrate.Service {
+	return migrate.New(s.NewConnector(operations))
+}
+
+func (s *Service) NewCallService(operations client.Operations) *call.Service {
+	return call.New(s.NewConnector(operations))
+}
+
 func (s *Service) NewMetaService(operations client.Operations) *meta.Service {
-	return meta.New(s.NewConnector(operations))
+	return meta.New(s.NewConnector(operations), meta.WithCache(s.metaCache), meta.WithAnnotations(s.annotations))
 }
 
 func (s *Service) NewConnector(operations client.Operations) *connector.Service {
@@ -88,11 +111,26 @@ func NewService(config *Config) *Service {
 		useText = true
 	}
 
+	// Metadata cache is shared across clients; keys are namespace scoped and
+	// entries of a connector are dropped after DDL executed through the toolbox
+	// and when the connector is replaced or removed.
+	metaCache := meta.NewCache(config.MetadataCacheTTL())
+	connectors.OnSchemaChange(metaCache.Invalidate)
+	connectors.OnConnectorChange(metaCache.Invalidate)
+
+	// Resource sessions are told about connector changes and DDL in their namespace.
+	resources := newResourceNotifier()
+	connectors.OnSchemaChange(resources.changed)
+	connectors.OnConnectorChange(resources.changed)
+
 	ret := &Service{
-		connectors: connectors,
-		ui:         interaction.New(connectors, secrets),
-		auth:       authService,
-		useText:    useText,
+		connectors:  connectors,
+		ui:          interaction.New(connectors, secrets),
+		auth:        authService,
+		metaCache:   metaCache,
+		annotations: meta.NewAnnotationStore(config.AnnotationsURL),
+		resources:   resources,
+		useText:     useText,
 	}
 	return ret
 }
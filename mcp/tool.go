@@ -8,9 +8,14 @@ import (
 	"github.com/viant/mcp-protocol/schema"
 	protoserver "github.com/viant/mcp-protocol/server"
 
+	"github.com/viant/mcp-sqlkit/db/call"
 	"github.com/viant/mcp-sqlkit/db/connector"
 	"github.com/viant/mcp-sqlkit/db/exec"
+	"github.com/viant/mcp-sqlkit/db/load"
+	"github.com/viant/mcp-sqlkit/db/meta"
+	"github.com/viant/mcp-sqlkit/db/migrate"
 	"github.com/viant/mcp-sqlkit/db/query"
+	"github.com/viant/mcp-sqlkit/db/upsert"
 )
 
 // Embedded markdown descriptions for tools
@@ -21,18 +26,80 @@ var dbQueryDesc string
 //go:embed descriptions/dbExec.md
 var dbExecDesc string
 
+//go:embed descriptions/dbUpsert.md
+var dbUpsertDesc string
+
+//go:embed descriptions/dbLoad.md
+var dbLoadDesc string
+
+//go:embed descriptions/dbMigrate.md
+var dbMigrateDesc string
+
+//go:embed descriptions/dbCall.md
+var dbCallDesc string
+
 //go:embed descriptions/dbListConnections.md
 var dbListConnectionsDesc string
 
 //go:embed descriptions/dbSetConnection.md
 var dbSetConnectionDesc string
 
-//
-////go:embed descriptions/dbListTables.md
-//var dbListTablesDesc string
-//
-////go:embed descriptions/dbListColumns.md
-//var dbListColumnsDesc string
+//go:embed descriptions/dbRemoveConnection.md
+var dbRemoveConnectionDesc string
+
+//go:embed descriptions/dbTestConnection.md
+var dbTestConnectionDesc string
+
+//go:embed descriptions/dbPoolStats.md
+var dbPoolStatsDesc string
+
+//go:embed descriptions/dbListTables.md
+var dbListTablesDesc string
+
+//go:embed descriptions/dbListColumns.md
+var dbListColumnsDesc string
+
+//go:embed descriptions/dbListSchemas.md
+var dbListSchemasDesc string
+
+//go:embed descriptions/dbDescribeTable.md
+var dbDescribeTableDesc string
+
+//go:embed descriptions/dbShowCreate.md
+var dbShowCreateDesc string
+
+//go:embed descriptions/dbDiagram.md
+var dbDiagramDesc string
+
+//go:embed descriptions/dbSearchSchema.md
+var dbSearchSchemaDesc string
+
+//go:embed descriptions/dbSchemaDiff.md
+var dbSchemaDiffDesc string
+
+//go:embed descriptions/dbTableStats.md
+var dbTableStatsDesc string
+
+//go:embed descriptions/dbListViews.md
+var dbListViewsDesc string
+
+//go:embed descriptions/dbListRoutines.md
+var dbListRoutinesDesc string
+
+//go:embed descriptions/dbListSequences.md
+var dbListSequencesDesc string
+
+//go:embed descriptions/dbInfo.md
+var dbInfoDesc string
+
+//go:embed descriptions/dbAnnotate.md
+var dbAnnotateDesc string
+
+//go:embed descriptions/dbDataDictionary.md
+var dbDataDictionaryDesc string
+
+//go:embed descriptions/dbSchemaDigest.md
+var dbSchemaDigestDesc string
 
 func registerTools(base *protoserver.DefaultHandler, ret *Handler) error {
 	// Register query tool
@@ -54,11 +121,58 @@ func registerTools(base *protoserver.DefaultHandler, ret *Handler) error {
 		}
 		// compact execution result (omit status field)
 		summary := map[string]interface{}{"rowsAffected": out.RowsAffected, "lastInsertId": out.LastInsertId}
+		if out.Data != nil {
+			summary["data"] = out.Data
+		}
 		return buildSuccessResult(ret.service, summary)
 	}); err != nil {
 		return err
 	}
 
+	// Register upsert tool
+	if err := protoserver.RegisterTool[*upsert.Input, *upsert.Output](base.Registry, "dbUpsert", dbUpsertDesc, func(ctx context.Context, input *upsert.Input) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.upsert.Upsert(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register load tool
+	if err := protoserver.RegisterTool[*load.Input, *load.Output](base.Registry, "dbLoad", dbLoadDesc, func(ctx context.Context, input *load.Input) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.load.Load(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register migrate tool
+	if err := protoserver.RegisterTool[*migrate.Input, *migrate.Output](base.Registry, "dbMigrate", dbMigrateDesc, func(ctx context.Context, input *migrate.Input) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.migrate.Migrate(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register call tool
+	if err := protoserver.RegisterTool[*call.Input, *call.Output](base.Registry, "dbCall", dbCallDesc, func(ctx context.Context, input *call.Input) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.call.Call(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
 	// Register list connections tool
 	if err := protoserver.RegisterTool[*connector.ListInput, *connector.ListOutput](base.Registry, "dbListConnections", dbListConnectionsDesc, func(ctx context.Context, input *connector.ListInput) (*schema.CallToolResult, *jsonrpc.Error) {
 		out := ret.connectors.ListConnectors(ctx, input)
@@ -81,26 +195,213 @@ func registerTools(base *protoserver.DefaultHandler, ret *Handler) error {
 		return err
 	}
 
-	//// Register list tables tool
-	//if err := protoserver.RegisterTool[*meta.ListTablesInput, *meta.TablesOutput](base.Registry, "dbListTables", dbListTablesDesc, func(ctx context.Context, input *meta.ListTablesInput) (*schema.CallToolResult, *jsonrpc.Error) {
-	//	out := ret.meta.ListTables(ctx, input)
-	//	if out.Status == "error" {
-	//		return buildErrorResult(out.Error)
-	//	}
-	//	return buildSuccessResult(ret.service, out)
-	//}); err != nil {
-	//	return err
-	//}
-	//
-	//// Register list columns tool
-	//if err := protoserver.RegisterTool[*meta.ListColumnsInput, *meta.ColumnsOutput](base.Registry, "dbListColumns", dbListColumnsDesc, func(ctx context.Context, input *meta.ListColumnsInput) (*schema.CallToolResult, *jsonrpc.Error) {
-	//	out := ret.meta.ListColumns(ctx, input)
-	//	if out.Status == "error" {
-	//		return buildErrorResult(out.Error)
-	//	}
-	//	return buildSuccessResult(ret.service, out)
-	//}); err != nil {
-	//	return err
-	//}
+	// Register remove connection tool (confirmation + pool, pending and secret cleanup)
+	if err := protoserver.RegisterTool[*connector.RemoveInput, *connector.RemoveOutput](base.Registry, "dbRemoveConnection", dbRemoveConnectionDesc, func(ctx context.Context, input *connector.RemoveInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out, err := ret.connectors.RemoveConnection(ctx, input)
+		if err != nil {
+			return buildErrorResult(err.Error())
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register test connection tool (dial, ping, version query with classified failures)
+	if err := protoserver.RegisterTool[*connector.TestConnectionInput, *connector.TestConnectionOutput](base.Registry, "dbTestConnection", dbTestConnectionDesc, func(ctx context.Context, input *connector.TestConnectionInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out, err := ret.connectors.TestConnection(ctx, input)
+		if err != nil {
+			return buildErrorResult(err.Error())
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register pool stats tool (pool settings and database/sql statistics)
+	if err := protoserver.RegisterTool[*connector.PoolStatsInput, *connector.PoolStatsOutput](base.Registry, "dbPoolStats", dbPoolStatsDesc, func(ctx context.Context, input *connector.PoolStatsInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.connectors.PoolStats(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register list schemas tool
+	if err := protoserver.RegisterTool[*meta.ListSchemasInput, *meta.SchemasOutput](base.Registry, "dbListSchemas", dbListSchemasDesc, func(ctx context.Context, input *meta.ListSchemasInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.ListSchemas(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register list tables tool
+	if err := protoserver.RegisterTool[*meta.ListTablesInput, *meta.TablesOutput](base.Registry, "dbListTables", dbListTablesDesc, func(ctx context.Context, input *meta.ListTablesInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.ListTables(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register list columns tool
+	if err := protoserver.RegisterTool[*meta.ListColumnsInput, *meta.ColumnsOutput](base.Registry, "dbListColumns", dbListColumnsDesc, func(ctx context.Context, input *meta.ListColumnsInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.ListColumns(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register describe table tool
+	if err := protoserver.RegisterTool[*meta.DescribeTableInput, *meta.DescribeTableOutput](base.Registry, "dbDescribeTable", dbDescribeTableDesc, func(ctx context.Context, input *meta.DescribeTableInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.DescribeTable(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register show create tool
+	if err := protoserver.RegisterTool[*meta.ShowCreateInput, *meta.ShowCreateOutput](base.Registry, "dbShowCreate", dbShowCreateDesc, func(ctx context.Context, input *meta.ShowCreateInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.ShowCreate(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register diagram tool
+	if err := protoserver.RegisterTool[*meta.DiagramInput, *meta.DiagramOutput](base.Registry, "dbDiagram", dbDiagramDesc, func(ctx context.Context, input *meta.DiagramInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.Diagram(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register cross-connector schema search tool
+	if err := protoserver.RegisterTool[*meta.SearchSchemaInput, *meta.SearchSchemaOutput](base.Registry, "dbSearchSchema", dbSearchSchemaDesc, func(ctx context.Context, input *meta.SearchSchemaInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.SearchSchema(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register schema diff tool
+	if err := protoserver.RegisterTool[*meta.SchemaDiffInput, *meta.SchemaDiffOutput](base.Registry, "dbSchemaDiff", dbSchemaDiffDesc, func(ctx context.Context, input *meta.SchemaDiffInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.SchemaDiff(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register table statistics tool
+	if err := protoserver.RegisterTool[*meta.TableStatsInput, *meta.TableStatsOutput](base.Registry, "dbTableStats", dbTableStatsDesc, func(ctx context.Context, input *meta.TableStatsInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.TableStats(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register views listing tool
+	if err := protoserver.RegisterTool[*meta.ListObjectsInput, *meta.ViewsOutput](base.Registry, "dbListViews", dbListViewsDesc, func(ctx context.Context, input *meta.ListObjectsInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.ListViews(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register stored procedures/functions listing tool
+	if err := protoserver.RegisterTool[*meta.ListObjectsInput, *meta.RoutinesOutput](base.Registry, "dbListRoutines", dbListRoutinesDesc, func(ctx context.Context, input *meta.ListObjectsInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.ListRoutines(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register sequences listing tool
+	if err := protoserver.RegisterTool[*meta.ListObjectsInput, *meta.SequencesOutput](base.Registry, "dbListSequences", dbListSequencesDesc, func(ctx context.Context, input *meta.ListObjectsInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.ListSequences(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register server and session info tool
+	if err := protoserver.RegisterTool[*meta.InfoInput, *meta.InfoOutput](base.Registry, "dbInfo", dbInfoDesc, func(ctx context.Context, input *meta.InfoInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.Info(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register annotate tool (table/column business annotations)
+	if err := protoserver.RegisterTool[*meta.AnnotateInput, *meta.AnnotateOutput](base.Registry, "dbAnnotate", dbAnnotateDesc, func(ctx context.Context, input *meta.AnnotateInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.Annotate(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register data dictionary tool
+	if err := protoserver.RegisterTool[*meta.DataDictionaryInput, *meta.DataDictionaryOutput](base.Registry, "dbDataDictionary", dbDataDictionaryDesc, func(ctx context.Context, input *meta.DataDictionaryInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.DataDictionary(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
+
+	// Register schema digest tool
+	if err := protoserver.RegisterTool[*meta.SchemaDigestInput, *meta.SchemaDigestOutput](base.Registry, "dbSchemaDigest", dbSchemaDigestDesc, func(ctx context.Context, input *meta.SchemaDigestInput) (*schema.CallToolResult, *jsonrpc.Error) {
+		out := ret.meta.SchemaDigest(ctx, input)
+		if out.Status == "error" {
+			return buildErrorResult(out.Error)
+		}
+		return buildSuccessResult(ret.service, out)
+	}); err != nil {
+		return err
+	}
 	return nil
 }